@@ -0,0 +1,74 @@
+package checks
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// AccessRequirement is the access a check type needs from the token
+type AccessRequirement struct {
+	// Run is the repository role needed to run the check
+	Run string
+	// Fix is the repository role needed to apply its fixes through the API, or "" if it
+	// has none; fixes that only write the working tree need no further access
+	Fix string
+	// Scope is a classic token scope the check needs beyond repository access
+	Scope string
+}
+
+// checkAccess maps each check type to the access it needs. Checks that only read the
+// working tree still fetch the repository, so they need read access.
+var checkAccess = map[CheckType]AccessRequirement{
+	CheckTypeSettings:     {Run: github.RoleAdmin, Fix: github.RoleAdmin},
+	CheckTypeActions:      {Run: github.RoleRead, Fix: github.RoleWrite},
+	CheckTypeRulesets:     {Run: github.RoleAdmin, Fix: github.RoleAdmin},
+	CheckTypeFiles:        {Run: github.RoleRead},
+	CheckTypeAutolinks:    {Run: github.RoleAdmin, Fix: github.RoleAdmin},
+	CheckTypeBranches:     {Run: github.RoleRead, Fix: github.RoleWrite},
+	CheckTypeFunding:      {Run: github.RoleRead},
+	CheckTypeLabels:       {Run: github.RoleRead, Fix: github.RoleWrite},
+	CheckTypeTopics:       {Run: github.RoleRead, Fix: github.RoleAdmin},
+	CheckTypeLanguages:    {Run: github.RoleRead},
+	CheckTypeTemplates:    {Run: github.RoleRead},
+	CheckTypeBranchRules:  {Run: github.RoleRead, Fix: github.RoleAdmin},
+	CheckTypeReadme:       {Run: github.RoleRead},
+	CheckTypeCheckRuns:    {Run: github.RoleRead},
+	CheckTypeConsistency:  {Run: github.RoleAdmin},
+	CheckTypeOrganization: {Scope: "admin:org"},
+	CheckTypeDependabot:   {Run: github.RoleRead},
+	CheckTypeCustom:       {Run: github.RoleRead},
+}
+
+// RequiredAccess returns the access a check type needs
+func RequiredAccess(checkType CheckType) AccessRequirement {
+	return checkAccess[checkType]
+}
+
+// Shortfall describes the access a token lacks to run the check, or with fix to also
+// apply its fixes, or returns "" if it has enough. role is the token's repository role.
+// scopes are its classic OAuth scopes, or nil for tokens that do not report scopes, in
+// which case only the role is compared. Private repositories need the repo scope.
+func (a AccessRequirement) Shortfall(role string, scopes []string, private, fix bool) string {
+	required := a.Run
+	if fix && a.Fix != "" {
+		required = a.Fix
+	}
+
+	if scopes != nil {
+		switch {
+		case a.Scope != "" && !slices.Contains(scopes, a.Scope):
+			return fmt.Sprintf("needs the %s scope", a.Scope)
+		case required != "" && private && !slices.Contains(scopes, "repo"):
+			return "needs the repo scope for a private repository"
+		case fix && a.Fix != "" && !slices.Contains(scopes, "repo") && !slices.Contains(scopes, "public_repo"):
+			return "fixes need the public_repo scope"
+		}
+	}
+
+	if required != "" && !github.RoleAtLeast(role, required) {
+		return fmt.Sprintf("needs %s, token has %s", required, role)
+	}
+	return ""
+}
@@ -0,0 +1,33 @@
+package checks
+
+import "testing"
+
+func TestAccessShortfall(t *testing.T) {
+	tests := []struct {
+		name    string
+		access  AccessRequirement
+		role    string
+		scopes  []string
+		private bool
+		fix     bool
+		want    string
+	}{
+		{"enough role", RequiredAccess(CheckTypeRulesets), "admin", nil, false, false, ""},
+		{"role too low", RequiredAccess(CheckTypeRulesets), "write", nil, false, false, "needs admin, token has write"},
+		{"fix role too low", RequiredAccess(CheckTypeLabels), "read", nil, false, true, "needs write, token has read"},
+		{"run without fix role", RequiredAccess(CheckTypeLabels), "read", nil, false, false, ""},
+		{"working tree fix", RequiredAccess(CheckTypeFiles), "read", []string{}, false, true, ""},
+		{"private without repo scope", RequiredAccess(CheckTypeLabels), "admin", []string{"public_repo"}, true, false, "needs the repo scope for a private repository"},
+		{"public fix without scope", RequiredAccess(CheckTypeLabels), "admin", []string{}, false, true, "fixes need the public_repo scope"},
+		{"missing scope", RequiredAccess(CheckTypeOrganization), "none", []string{"repo", "read:org"}, false, false, "needs the admin:org scope"},
+		{"unreported scopes", RequiredAccess(CheckTypeOrganization), "none", nil, false, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.access.Shortfall(tt.role, tt.scopes, tt.private, tt.fix); got != tt.want {
+				t.Errorf("Shortfall() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,10 +3,12 @@ package checks
 import (
 	"context"
 	"fmt"
-	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 
@@ -71,6 +73,33 @@ func (c *ActionsCheck) Run(ctx context.Context) ([]Issue, error) {
 		issues = append(issues, wfIssues...)
 	}
 
+	// Check workflow names across all workflow files
+	if c.config.RequireWorkflowName != nil && *c.config.RequireWorkflowName {
+		nameIssues, err := c.checkWorkflowNames(workflowFiles)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, nameIssues...)
+	}
+
+	// Check schedule budget across all workflow files
+	if c.config.MaxScheduledWorkflows != nil || c.config.MinScheduleIntervalMinutes != nil {
+		scheduleIssues, err := c.checkSchedules(workflowFiles)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, scheduleIssues...)
+	}
+
+	// Check that scheduled workflows can be run manually and avoid the midnight herd
+	if c.config.RequireScheduleDispatch != nil && *c.config.RequireScheduleDispatch {
+		dispatchIssues, err := c.checkScheduleDispatch(workflowFiles)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, dispatchIssues...)
+	}
+
 	return issues, nil
 }
 
@@ -78,7 +107,11 @@ func (c *ActionsCheck) checkWorkflow(wfConfig config.WorkflowConfig) ([]Issue, e
 	var issues []Issue
 
 	// Check if workflow file exists
-	if !c.client.FileExists(wfConfig.Path) {
+	exists, err := c.client.FileExists(wfConfig.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check workflow %s: %w", wfConfig.Path, err)
+	}
+	if !exists {
 		issues = append(issues, Issue{
 			Type:    c.Type(),
 			Name:    c.Name(),
@@ -101,22 +134,80 @@ func (c *ActionsCheck) checkWorkflow(wfConfig config.WorkflowConfig) ([]Issue, e
 		issues = append(issues, matchIssues...)
 	}
 
+	if len(wfConfig.RequiredJobs) > 0 {
+		jobIssues, err := c.checkRequiredJobs(wfConfig)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, jobIssues...)
+	}
+
+	if c.config.RequireActiveWorkflows != nil && *c.config.RequireActiveWorkflows {
+		stateIssues, err := c.checkWorkflowState(wfConfig)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, stateIssues...)
+	}
+
 	return issues, nil
 }
 
-func (c *ActionsCheck) checkWorkflowReference(wfConfig config.WorkflowConfig) ([]Issue, error) {
+// checkWorkflowState reports a required workflow that is disabled in GitHub Actions, so
+// it does not run even though its file is compliant
+func (c *ActionsCheck) checkWorkflowState(wfConfig config.WorkflowConfig) ([]Issue, error) {
+	fileName := filepath.Base(wfConfig.Path)
+	workflow, err := c.client.GetActionsWorkflow(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch workflow %s: %w", fileName, err)
+	}
+
+	// Workflows not yet on the default branch are not registered with Actions
+	if workflow == nil || workflow.State == "active" {
+		return nil, nil
+	}
+
+	return []Issue{{
+		Type:    c.Type(),
+		Name:    c.Name(),
+		File:    wfConfig.Path,
+		Message: fmt.Sprintf("Required workflow '%s' is %s", wfConfig.Path, strings.ReplaceAll(workflow.State, "_", " ")),
+		// Deleted workflows and workflows disabled because the repository is a fork cannot be enabled
+		Fixable: workflow.State == "disabled_manually" || workflow.State == "disabled_inactivity",
+		Data:    map[string]string{DataKeyWorkflow: fileName},
+	}}, nil
+}
+
+// checkRequiredJobs reports required job IDs that are missing from a workflow
+func (c *ActionsCheck) checkRequiredJobs(wfConfig config.WorkflowConfig) ([]Issue, error) {
 	var issues []Issue
 
-	// Parse reference: owner/repo/path
-	parts := strings.SplitN(wfConfig.Reference, "/", 3)
-	if len(parts) < 3 {
-		return nil, fmt.Errorf("invalid reference format: %s (expected owner/repo/path)", wfConfig.Reference)
+	wf, _, err := c.client.ReadWorkflowFile(wfConfig.Path)
+	if err != nil {
+		return nil, err
 	}
 
-	refOwner, refRepo, refPath := parts[0], parts[1], parts[2]
+	for _, job := range wfConfig.RequiredJobs {
+		if _, ok := wf.Jobs[job]; ok {
+			continue
+		}
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			File:    wfConfig.Path,
+			Message: fmt.Sprintf("Workflow '%s' is missing required job '%s'", wfConfig.Path, job),
+			Fixable: false,
+		})
+	}
+
+	return issues, nil
+}
 
-	// Fetch reference content
-	refContent, err := c.client.GetRemoteFileContent(refOwner, refRepo, refPath)
+func (c *ActionsCheck) checkWorkflowReference(wfConfig config.WorkflowConfig) ([]Issue, error) {
+	var issues []Issue
+
+	// Fetch reference content (local path, owner/repo/path or a GitHub URL)
+	refContent, err := github.ResolveReferenceFile(wfConfig.Reference, c.client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch reference workflow: %w", err)
 	}
@@ -133,10 +224,15 @@ func (c *ActionsCheck) checkWorkflowReference(wfConfig config.WorkflowConfig) ([
 	}
 
 	// Compare YAML structures (not raw content)
-	if !yamlEqual(string(interpolatedRef), string(actualContent)) {
+	equal := yamlEqual
+	if wfConfig.IgnorePinnedVersions != nil && *wfConfig.IgnorePinnedVersions {
+		equal = yamlEqualIgnoringPins
+	}
+	if !equal(string(interpolatedRef), string(actualContent)) {
 		issues = append(issues, Issue{
 			Type:    c.Type(),
 			Name:    c.Name(),
+			File:    wfConfig.Path,
 			Message: fmt.Sprintf("Workflow '%s' does not match reference '%s'", wfConfig.Path, wfConfig.Reference),
 			Fixable: true,
 			Data: map[string]string{
@@ -152,24 +248,18 @@ func (c *ActionsCheck) checkWorkflowReference(wfConfig config.WorkflowConfig) ([
 func (c *ActionsCheck) findWorkflowFiles() ([]string, error) {
 	workflowDir := ".github/workflows"
 
-	entries, err := os.ReadDir(workflowDir)
+	names, err := c.client.ListLocalFiles(workflowDir)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil, nil
-		}
 		return nil, err
 	}
 
 	var files []string
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
-		}
-		name := entry.Name()
+	for _, name := range names {
 		if strings.HasSuffix(name, ".yml") || strings.HasSuffix(name, ".yaml") {
 			files = append(files, filepath.Join(workflowDir, name))
 		}
 	}
+	sort.Strings(files)
 
 	return files, nil
 }
@@ -177,10 +267,12 @@ func (c *ActionsCheck) findWorkflowFiles() ([]string, error) {
 func (c *ActionsCheck) checkWorkflowRules(wfPath string) ([]Issue, error) {
 	var issues []Issue
 
-	wf, content, err := github.ReadLocalWorkflowFile(wfPath)
+	wf, content, err := c.client.ReadWorkflowFile(wfPath)
 	if err != nil {
 		return nil, err
 	}
+	// Jobs guarded by if: false never run, so rules skip them; step rules skip such steps
+	wf = enabledJobs(wf)
 
 	// Check pinned versions
 	if c.config.RequirePinnedVersions != nil && *c.config.RequirePinnedVersions {
@@ -194,12 +286,92 @@ func (c *ActionsCheck) checkWorkflowRules(wfPath string) ([]Issue, error) {
 		issues = append(issues, timeoutIssues...)
 	}
 
+	// Check step timeouts
+	if c.config.MaxStepTimeoutMinutes != nil || c.config.RequireStepTimeoutAboveMinutes != nil {
+		issues = append(issues, c.checkStepTimeouts(wfPath, wf)...)
+	}
+
 	// Check minimal permissions
 	if c.config.RequireMinimalPermissions != nil && *c.config.RequireMinimalPermissions {
 		permIssues := c.checkPermissions(wfPath, wf)
 		issues = append(issues, permIssues...)
 	}
 
+	// Check for hardcoded secrets
+	if c.config.ForbidHardcodedSecrets != nil && *c.config.ForbidHardcodedSecrets {
+		issues = append(issues, c.checkHardcodedSecrets(wfPath, wf)...)
+	}
+
+	// Check for secrets in env blocks of pull request workflows
+	if c.config.ForbidPullRequestSecretEnv != nil && *c.config.ForbidPullRequestSecretEnv {
+		issues = append(issues, c.checkPullRequestSecretEnv(wfPath, wf)...)
+	}
+
+	// Check for deprecated workflow commands
+	if c.config.ForbidDeprecatedCommands != nil && *c.config.ForbidDeprecatedCommands {
+		issues = append(issues, c.checkDeprecatedCommands(wfPath, wf)...)
+	}
+
+	// Check pull_request_target is only used by allowlisted workflows
+	if c.config.RestrictPullRequestTarget != nil && *c.config.RestrictPullRequestTarget {
+		issues = append(issues, c.checkPullRequestTarget(wfPath, wf)...)
+	}
+
+	// Check for write permissions that no step appears to use
+	if c.config.DetectUnusedWritePermissions != nil && *c.config.DetectUnusedWritePermissions {
+		issues = append(issues, c.checkUnusedWritePermissions(wfPath, wf)...)
+	}
+
+	// Check id-token: write is only granted to OIDC jobs
+	if len(c.config.OIDCJobs) > 0 {
+		oidcIssues, err := c.checkOIDCPermissions(wfPath, wf)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, oidcIssues...)
+	}
+
+	// Check dependency caching
+	if len(c.config.RequireDependencyCache) > 0 {
+		cacheIssues, err := c.checkDependencyCache(wfPath, wf)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, cacheIssues...)
+	}
+
+	// Check runner labels
+	if (c.config.ForbidLatestRunners != nil && *c.config.ForbidLatestRunners) || len(c.config.PinnedRunners) > 0 {
+		issues = append(issues, c.checkRunners(wfPath, wf)...)
+	}
+
+	// Check deployment environments
+	if c.config.RequireProtectedEnvironments != nil && *c.config.RequireProtectedEnvironments {
+		envIssues, err := c.checkEnvironments(wfPath, wf)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, envIssues...)
+	}
+
+	// Check actions/checkout fetch-depth
+	if len(c.config.CheckoutFetchDepth) > 0 {
+		depthIssues, err := c.checkFetchDepth(wfPath, wf)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, depthIssues...)
+	}
+
+	// Check reusable workflow inputs
+	if c.config.ValidateReusableWorkflowInputs != nil && *c.config.ValidateReusableWorkflowInputs {
+		inputIssues, err := c.checkReusableWorkflowInputs(wfPath, wf)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, inputIssues...)
+	}
+
 	return issues, nil
 }
 
@@ -208,11 +380,11 @@ func (c *ActionsCheck) checkPinnedVersions(wfPath, content string) []Issue {
 
 	// Regex to match uses: statements
 	usesRegex := regexp.MustCompile(`uses:\s*([^\s@]+)@([^\s]+)`)
-	matches := usesRegex.FindAllStringSubmatch(content, -1)
+	matches := usesRegex.FindAllStringSubmatchIndex(content, -1)
 
 	for _, match := range matches {
-		action := match[1]
-		version := match[2]
+		action := content[match[2]:match[3]]
+		version := content[match[4]:match[5]]
 
 		// Skip first-party actions (actions/*, github/*, cli/*, and dependabot/*)
 		if strings.HasPrefix(action, "actions/") ||
@@ -227,6 +399,8 @@ func (c *ActionsCheck) checkPinnedVersions(wfPath, content string) []Issue {
 			issues = append(issues, Issue{
 				Type:    c.Type(),
 				Name:    c.Name(),
+				File:    wfPath,
+				Line:    lineNumber(content, match[0]),
 				Message: fmt.Sprintf("Action '%s@%s' in '%s' is not pinned to a SHA", action, version, wfPath),
 				Fixable: false,
 			})
@@ -239,11 +413,13 @@ func (c *ActionsCheck) checkPinnedVersions(wfPath, content string) []Issue {
 func (c *ActionsCheck) checkTimeout(wfPath string, wf *github.Workflow) []Issue {
 	var issues []Issue
 
-	for jobName, job := range wf.Jobs {
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		job := wf.Jobs[jobName]
 		if job.TimeoutMinutes == 0 {
 			issues = append(issues, Issue{
 				Type:    c.Type(),
 				Name:    c.Name(),
+				File:    wfPath,
 				Message: fmt.Sprintf("Job '%s' in '%s' does not have timeout-minutes set", jobName, wfPath),
 				Fixable: false,
 			})
@@ -251,6 +427,7 @@ func (c *ActionsCheck) checkTimeout(wfPath string, wf *github.Workflow) []Issue
 			issues = append(issues, Issue{
 				Type:    c.Type(),
 				Name:    c.Name(),
+				File:    wfPath,
 				Message: fmt.Sprintf("Job '%s' in '%s' has timeout-minutes (%d) exceeding maximum (%d)", jobName, wfPath, job.TimeoutMinutes, *c.config.MaxTimeoutMinutes),
 				Fixable: false,
 			})
@@ -260,6 +437,77 @@ func (c *ActionsCheck) checkTimeout(wfPath string, wf *github.Workflow) []Issue
 	return issues
 }
 
+// defaultJobTimeoutMinutes is the timeout GitHub applies to jobs without timeout-minutes
+const defaultJobTimeoutMinutes = 360
+
+// stepTimeoutMinutes returns a step's timeout-minutes, 0 when unset. ok is false when the
+// value is an expression, which is only known when the workflow runs.
+func stepTimeoutMinutes(value any) (minutes int, ok bool) {
+	switch v := value.(type) {
+	case nil:
+		return 0, true
+	case int:
+		return v, true
+	case float64:
+		return int(v), true
+	case string:
+		minutes, err := strconv.Atoi(strings.TrimSpace(v))
+		return minutes, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// checkStepTimeouts reports steps whose timeout-minutes exceeds max_step_timeout_minutes, and
+// steps without timeout-minutes in jobs whose timeout exceeds require_step_timeout_above_minutes
+func (c *ActionsCheck) checkStepTimeouts(wfPath string, wf *github.Workflow) []Issue {
+	var issues []Issue
+
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		job := wf.Jobs[jobName]
+		jobTimeout := job.TimeoutMinutes
+		if jobTimeout == 0 {
+			jobTimeout = defaultJobTimeoutMinutes
+		}
+		requireTimeout := c.config.RequireStepTimeoutAboveMinutes != nil && jobTimeout > *c.config.RequireStepTimeoutAboveMinutes
+
+		for i, step := range job.Steps {
+			if conditionDisabled(step.If) {
+				continue
+			}
+			stepTimeout, ok := stepTimeoutMinutes(step.TimeoutMinutes)
+			if !ok {
+				continue
+			}
+			stepName := step.Name
+			if stepName == "" {
+				stepName = fmt.Sprintf("#%d", i+1)
+			}
+
+			switch {
+			case stepTimeout == 0 && requireTimeout:
+				issues = append(issues, Issue{
+					Type:    c.Type(),
+					Name:    c.Name(),
+					File:    wfPath,
+					Message: fmt.Sprintf("Job '%s' step '%s' in '%s' does not have timeout-minutes set (job timeout is %d minutes)%s", jobName, stepName, wfPath, jobTimeout, conditionNote(step.If)),
+					Fixable: false,
+				})
+			case c.config.MaxStepTimeoutMinutes != nil && stepTimeout > *c.config.MaxStepTimeoutMinutes:
+				issues = append(issues, Issue{
+					Type:    c.Type(),
+					Name:    c.Name(),
+					File:    wfPath,
+					Message: fmt.Sprintf("Job '%s' step '%s' in '%s' has timeout-minutes (%d) exceeding maximum (%d)", jobName, stepName, wfPath, stepTimeout, *c.config.MaxStepTimeoutMinutes),
+					Fixable: false,
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
 func (c *ActionsCheck) checkPermissions(wfPath string, wf *github.Workflow) []Issue {
 	var issues []Issue
 
@@ -276,6 +524,7 @@ func (c *ActionsCheck) checkPermissions(wfPath string, wf *github.Workflow) []Is
 			issues = append(issues, Issue{
 				Type:    c.Type(),
 				Name:    c.Name(),
+				File:    wfPath,
 				Message: fmt.Sprintf("Workflow '%s' does not declare permissions at workflow or job level", wfPath),
 				Fixable: false,
 			})
@@ -285,7 +534,329 @@ func (c *ActionsCheck) checkPermissions(wfPath string, wf *github.Workflow) []Is
 	return issues
 }
 
+// checkHardcodedSecrets scans env, step run and step with values for hardcoded credentials
+// Only the kind of credential is reported, never the matched value
+func (c *ActionsCheck) checkHardcodedSecrets(wfPath string, wf *github.Workflow) []Issue {
+	var issues []Issue
+
+	report := func(location, field, value string) {
+		subject := fmt.Sprintf("Workflow '%s'", wfPath)
+		if location != "" {
+			subject += " " + location
+		}
+		for _, kind := range detectSecrets(value) {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: fmt.Sprintf("%s contains a hardcoded %s in %s", subject, kind, field),
+				Fixable: false,
+			})
+		}
+	}
+
+	for _, key := range sortedKeys(wf.Env) {
+		report("", "env."+key, wf.Env[key])
+	}
+
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		job := wf.Jobs[jobName]
+		jobLocation := fmt.Sprintf("job '%s'", jobName)
+
+		for _, key := range sortedKeys(job.Env) {
+			report(jobLocation, "env."+key, job.Env[key])
+		}
+
+		for i, step := range job.Steps {
+			if conditionDisabled(step.If) {
+				continue
+			}
+			stepName := step.Name
+			if stepName == "" {
+				stepName = step.ID
+			}
+			if stepName == "" {
+				stepName = fmt.Sprintf("#%d", i+1)
+			}
+			stepLocation := fmt.Sprintf("%s step '%s'%s", jobLocation, stepName, conditionNote(step.If))
+
+			report(stepLocation, "run", step.Run)
+			for _, key := range sortedKeys(step.With) {
+				report(stepLocation, "with."+key, step.With[key])
+			}
+			for _, key := range sortedKeys(step.Env) {
+				report(stepLocation, "env."+key, step.Env[key])
+			}
+		}
+	}
+
+	return issues
+}
+
+// pullRequestTargetAllowance returns the allowlist entry for a workflow, matched by its
+// path or file name, or nil if it is not allowlisted
+func pullRequestTargetAllowance(wfPath string, allowlist []config.PullRequestTargetConfig) *config.PullRequestTargetConfig {
+	for i, entry := range allowlist {
+		if entry.Workflow == wfPath || entry.Workflow == filepath.Base(wfPath) {
+			return &allowlist[i]
+		}
+	}
+	return nil
+}
+
+// checkPullRequestTarget flags workflows triggered by pull_request_target, which runs with
+// the base repository's secrets and a write token, unless they are allowlisted with a
+// justification reference that resolves
+func (c *ActionsCheck) checkPullRequestTarget(wfPath string, wf *github.Workflow) []Issue {
+	if _, ok := wf.Triggers()["pull_request_target"]; !ok {
+		return nil
+	}
+
+	var message string
+	entry := pullRequestTargetAllowance(wfPath, c.config.PullRequestTargetAllowlist)
+	if entry == nil {
+		message = fmt.Sprintf("Workflow '%s' is triggered by pull_request_target but is not in pull_request_target_allowlist", wfPath)
+	} else if _, err := github.ResolveReferenceFile(entry.Justification, c.client); err != nil {
+		message = fmt.Sprintf("Workflow '%s' is allowlisted for pull_request_target, but its justification '%s' could not be resolved: %v", wfPath, entry.Justification, err)
+	} else {
+		return nil
+	}
+
+	return []Issue{{
+		Type:    c.Type(),
+		Name:    c.Name(),
+		File:    wfPath,
+		Message: message,
+		Fixable: false,
+	}}
+}
+
+// pullRequestTriggers are the events that run workflows for pull requests from forks
+var pullRequestTriggers = []string{"pull_request", "pull_request_target"}
+
+// secretsExpressionRegex matches an expression that references the secrets context
+var secretsExpressionRegex = regexp.MustCompile(`\$\{\{[^}]*\bsecrets\.`)
+
+// checkPullRequestSecretEnv flags workflow-level and job-level env entries that reference
+// secrets in workflows triggered by pull requests. Under pull_request the secrets are
+// empty for forks, and under pull_request_target they are exposed to every step,
+// including ones that run fork code; either way they belong on the step that needs them.
+func (c *ActionsCheck) checkPullRequestSecretEnv(wfPath string, wf *github.Workflow) []Issue {
+	triggers := wf.Triggers()
+	var prTriggers []string
+	for _, trigger := range pullRequestTriggers {
+		if _, ok := triggers[trigger]; ok {
+			prTriggers = append(prTriggers, trigger)
+		}
+	}
+	if len(prTriggers) == 0 {
+		return nil
+	}
+	on := strings.Join(prTriggers, ", ")
+
+	var issues []Issue
+	report := func(location string, env map[string]string) {
+		for _, key := range sortedKeys(env) {
+			if !secretsExpressionRegex.MatchString(env[key]) {
+				continue
+			}
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: fmt.Sprintf("Workflow '%s' triggered by %s references secrets in %s env '%s'", wfPath, on, location, key),
+				Fixable: false,
+			})
+		}
+	}
+
+	report("workflow-level", wf.Env)
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		report(fmt.Sprintf("job '%s'", jobName), wf.Jobs[jobName].Env)
+	}
+
+	return issues
+}
+
+// sortedKeys returns the keys of a map in sorted order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// checkWorkflowNames verifies every workflow declares a name and that names are unique
+func (c *ActionsCheck) checkWorkflowNames(workflowFiles []string) ([]Issue, error) {
+	var issues []Issue
+	pathsByName := make(map[string][]string)
+
+	for _, wfPath := range workflowFiles {
+		wf, _, err := c.client.ReadWorkflowFile(wfPath)
+		if err != nil {
+			return nil, err
+		}
+
+		name := strings.TrimSpace(wf.Name)
+		if name == "" {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: fmt.Sprintf("Workflow '%s' does not declare a name", wfPath),
+				Fixable: false,
+			})
+			continue
+		}
+		pathsByName[name] = append(pathsByName[name], wfPath)
+	}
+
+	names := make([]string, 0, len(pathsByName))
+	for name := range pathsByName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		paths := pathsByName[name]
+		if len(paths) > 1 {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Workflow name '%s' is used by multiple files: %s", name, strings.Join(paths, ", ")),
+				Fixable: false,
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+// checkSchedules verifies the number of scheduled workflows and the frequency of their cron schedules
+func (c *ActionsCheck) checkSchedules(workflowFiles []string) ([]Issue, error) {
+	var issues []Issue
+	var scheduled []string
+
+	for _, wfPath := range workflowFiles {
+		wf, _, err := c.client.ReadWorkflowFile(wfPath)
+		if err != nil {
+			return nil, err
+		}
+
+		crons := wf.Schedules()
+		if len(crons) == 0 {
+			continue
+		}
+		scheduled = append(scheduled, wfPath)
+
+		if c.config.MinScheduleIntervalMinutes == nil {
+			continue
+		}
+		minInterval := time.Duration(*c.config.MinScheduleIntervalMinutes) * time.Minute
+
+		for _, cron := range crons {
+			interval, err := cronMinInterval(cron)
+			if err != nil {
+				issues = append(issues, Issue{
+					Type:    c.Type(),
+					Name:    c.Name(),
+					File:    wfPath,
+					Message: fmt.Sprintf("Workflow '%s' has an invalid schedule '%s': %v", wfPath, cron, err),
+					Fixable: false,
+				})
+				continue
+			}
+			if interval < minInterval {
+				issues = append(issues, Issue{
+					Type: c.Type(),
+					Name: c.Name(),
+					File: wfPath,
+					Message: fmt.Sprintf("Workflow '%s' schedule '%s' runs every %d minutes (minimum interval: %d)",
+						wfPath, cron, int(interval.Minutes()), *c.config.MinScheduleIntervalMinutes),
+					Fixable: false,
+				})
+			}
+		}
+	}
+
+	if c.config.MaxScheduledWorkflows != nil && len(scheduled) > *c.config.MaxScheduledWorkflows {
+		issues = append(issues, Issue{
+			Type: c.Type(),
+			Name: c.Name(),
+			Message: fmt.Sprintf("Repository has %d scheduled workflows (max: %d): %s",
+				len(scheduled), *c.config.MaxScheduledWorkflows, strings.Join(scheduled, ", ")),
+			Fixable: false,
+		})
+	}
+
+	return issues, nil
+}
+
+// checkScheduleDispatch verifies that each scheduled workflow also has a workflow_dispatch
+// trigger, and warns on crons that run at exactly midnight UTC, when GitHub's scheduler
+// is busiest and runs are most likely to be delayed or dropped
+func (c *ActionsCheck) checkScheduleDispatch(workflowFiles []string) ([]Issue, error) {
+	var issues []Issue
+
+	for _, wfPath := range workflowFiles {
+		wf, _, err := c.client.ReadWorkflowFile(wfPath)
+		if err != nil {
+			return nil, err
+		}
+
+		crons := wf.Schedules()
+		if len(crons) == 0 {
+			continue
+		}
+
+		if _, ok := wf.Triggers()["workflow_dispatch"]; !ok {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: fmt.Sprintf("Scheduled workflow '%s' does not have a workflow_dispatch trigger", wfPath),
+				Fixable: false,
+			})
+		}
+
+		for _, cron := range crons {
+			if isMidnightCron(cron) {
+				issues = append(issues, Issue{
+					Type:     c.Type(),
+					Name:     c.Name(),
+					Severity: SeverityWarning,
+					File:     wfPath,
+					Message:  fmt.Sprintf("Workflow '%s' schedule '%s' runs at midnight UTC; consider an off-peak minute and hour", wfPath, cron),
+					Fixable:  false,
+				})
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+// isMidnightCron reports whether a cron expression fires at exactly 00:00
+func isMidnightCron(expr string) bool {
+	fields := strings.Fields(expr)
+	return len(fields) == 5 && fields[0] == "0" && fields[1] == "0"
+}
+
 func yamlEqual(a, b string) bool {
+	return yamlEqualNormalized(a, b, nil)
+}
+
+// yamlEqualIgnoringPins is like yamlEqual, but treats `uses: owner/repo@<sha>` as
+// `uses: owner/repo`, so documents differing only in pinned commit SHAs are equal
+func yamlEqualIgnoringPins(a, b string) bool {
+	return yamlEqualNormalized(a, b, stripPinnedSHAs)
+}
+
+// yamlEqualNormalized compares two YAML documents structurally after applying normalize,
+// if not nil, to each decoded document
+func yamlEqualNormalized(a, b string, normalize func(any)) bool {
 	var aData, bData any
 	if err := yaml.Unmarshal([]byte(a), &aData); err != nil {
 		return false
@@ -293,6 +864,10 @@ func yamlEqual(a, b string) bool {
 	if err := yaml.Unmarshal([]byte(b), &bData); err != nil {
 		return false
 	}
+	if normalize != nil {
+		normalize(aData)
+		normalize(bData)
+	}
 
 	aBytes, _ := yaml.Marshal(aData)
 	bBytes, _ := yaml.Marshal(bData)
@@ -300,6 +875,27 @@ func yamlEqual(a, b string) bool {
 	return string(aBytes) == string(bBytes)
 }
 
+// stripPinnedSHAs removes the "@<sha>" ref from every `uses` value pinned to a commit SHA
+// in a decoded YAML document. Refs that are tags or branches are kept.
+func stripPinnedSHAs(node any) {
+	switch n := node.(type) {
+	case map[string]any:
+		for key, value := range n {
+			if uses, ok := value.(string); ok && key == "uses" {
+				if action, ref, found := strings.Cut(uses, "@"); found && isSHA(ref) {
+					n[key] = action
+				}
+				continue
+			}
+			stripPinnedSHAs(value)
+		}
+	case []any:
+		for _, item := range n {
+			stripPinnedSHAs(item)
+		}
+	}
+}
+
 func isSHA(version string) bool {
 	if len(version) != 40 {
 		return false
@@ -0,0 +1,118 @@
+package checks
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestYamlEqualIgnoringPins(t *testing.T) {
+	const sha1 = "11bd71901bbe5b1630ceea73d27597364c9af683"
+	const sha2 = "692973e3d937129bcbf40652eb9f2f61becf3332"
+	workflow := func(checkout, extra string) string {
+		return `on: push
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@` + checkout + `
+      - run: go test ./...` + extra + "\n"
+	}
+
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"same pin", workflow(sha1, ""), workflow(sha1, ""), true},
+		{"different pin", workflow(sha1, ""), workflow(sha2, ""), true},
+		{"tag differs", workflow("v4", ""), workflow("v5", ""), false},
+		{"pin and tag", workflow(sha1, ""), workflow("v4", ""), false},
+		{"structural difference", workflow(sha1, ""), workflow(sha2, " -race"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := yamlEqualIgnoringPins(tt.a, tt.b); got != tt.want {
+				t.Errorf("yamlEqualIgnoringPins() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if yamlEqual(workflow(sha1, ""), workflow(sha2, "")) {
+		t.Error("yamlEqual() ignored a pinned SHA difference")
+	}
+}
+
+func TestPullRequestTargetAllowance(t *testing.T) {
+	allowlist := []config.PullRequestTargetConfig{
+		{Workflow: "triage.yml", Justification: "docs/triage.md"},
+		{Workflow: ".github/workflows/label.yml", Justification: "docs/label.md"},
+	}
+
+	tests := []struct {
+		name   string
+		wfPath string
+		want   string
+	}{
+		{"file name", ".github/workflows/triage.yml", "docs/triage.md"},
+		{"path", ".github/workflows/label.yml", "docs/label.md"},
+		{"not allowlisted", ".github/workflows/build.yml", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if entry := pullRequestTargetAllowance(tt.wfPath, allowlist); entry != nil {
+				got = entry.Justification
+			}
+			if got != tt.want {
+				t.Errorf("pullRequestTargetAllowance() justification = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckStepTimeouts(t *testing.T) {
+	const content = `on: workflow_call
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - name: fixed
+        run: make
+        timeout-minutes: 45
+      - name: expression
+        run: make test
+        timeout-minutes: ${{ inputs.t }}
+      - name: unset
+        run: make lint
+`
+	var wf github.Workflow
+	if err := yaml.Unmarshal([]byte(content), &wf); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+
+	maxTimeout, requireAbove := 30, 60
+	c := &ActionsCheck{config: &config.ActionsConfig{
+		MaxStepTimeoutMinutes:          &maxTimeout,
+		RequireStepTimeoutAboveMinutes: &requireAbove,
+	}}
+	issues := c.checkStepTimeouts("ci.yml", &wf)
+
+	want := []string{
+		"Job 'build' step 'fixed' in 'ci.yml' has timeout-minutes (45) exceeding maximum (30)",
+		"Job 'build' step 'unset' in 'ci.yml' does not have timeout-minutes set (job timeout is 360 minutes)",
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("checkStepTimeouts() returned %d issues, want %d: %+v", len(issues), len(want), issues)
+	}
+	for i, issue := range issues {
+		if issue.Message != want[i] {
+			t.Errorf("issue %d message = %q, want %q", i, issue.Message, want[i])
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// AutolinksCheck validates repository autolink references
+type AutolinksCheck struct {
+	client  *github.Client
+	config  []config.AutolinkConfig
+	verbose bool
+}
+
+// NewAutolinksCheck creates a new autolinks check
+func NewAutolinksCheck(client *github.Client, cfgs []config.AutolinkConfig, verbose bool) *AutolinksCheck {
+	return &AutolinksCheck{
+		client:  client,
+		config:  cfgs,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *AutolinksCheck) Type() CheckType {
+	return CheckTypeAutolinks
+}
+
+// Name returns the check name
+func (c *AutolinksCheck) Name() string {
+	return "autolinks"
+}
+
+// Run executes the autolinks check
+func (c *AutolinksCheck) Run(ctx context.Context) ([]Issue, error) {
+	if len(c.config) == 0 {
+		return nil, nil
+	}
+
+	autolinks, err := c.client.GetAutolinks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch autolinks: %w", err)
+	}
+
+	existing := make(map[string]github.Autolink)
+	for _, al := range autolinks {
+		existing[al.KeyPrefix] = al
+	}
+
+	var issues []Issue
+
+	for _, expected := range c.config {
+		data := map[string]string{DataKeyKeyPrefix: expected.KeyPrefix}
+
+		actual, ok := existing[expected.KeyPrefix]
+		if !ok {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Autolink '%s' does not exist", expected.KeyPrefix),
+				Fixable: true,
+				Data:    data,
+			})
+			continue
+		}
+
+		if actual.URLTemplate != expected.URLTemplate {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Autolink '%s' has URL template '%s' but should be '%s'", expected.KeyPrefix, actual.URLTemplate, expected.URLTemplate),
+				Fixable: true,
+				Data:    data,
+			})
+		}
+
+		if expected.IsAlphanumeric != nil && actual.IsAlphanumeric != *expected.IsAlphanumeric {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Autolink '%s' alphanumeric matching is %s but should be %s", expected.KeyPrefix, boolToEnabled(actual.IsAlphanumeric), boolToEnabled(*expected.IsAlphanumeric)),
+				Fixable: true,
+				Data:    data,
+			})
+		}
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,150 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gobwas/glob"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// BranchesCheck validates repository branch hygiene
+type BranchesCheck struct {
+	client  *github.Client
+	config  *config.BranchesConfig
+	verbose bool
+}
+
+// NewBranchesCheck creates a new branches check
+func NewBranchesCheck(client *github.Client, cfg *config.BranchesConfig, verbose bool) *BranchesCheck {
+	return &BranchesCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *BranchesCheck) Type() CheckType {
+	return CheckTypeBranches
+}
+
+// Name returns the check name
+func (c *BranchesCheck) Name() string {
+	return "branches"
+}
+
+// Run executes the branches check
+func (c *BranchesCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil {
+		return nil, nil
+	}
+
+	var issues []Issue
+
+	if c.config.StaleAfterDays != nil {
+		staleIssues, err := c.checkStaleBranches(*c.config.StaleAfterDays)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, staleIssues...)
+	}
+
+	if len(c.config.ForbiddenBranches) > 0 {
+		forbiddenIssues, err := c.checkForbiddenBranches(c.config.ForbiddenBranches)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, forbiddenIssues...)
+	}
+
+	return issues, nil
+}
+
+// checkForbiddenBranches reports branches matching any forbidden pattern
+// Deleting the default branch or a protected branch is never offered as a fix
+func (c *BranchesCheck) checkForbiddenBranches(patterns []string) ([]Issue, error) {
+	globs := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid forbidden_branches pattern: %w", err)
+		}
+		globs = append(globs, g)
+	}
+
+	repo, err := c.client.GetRepository()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch repository: %w", err)
+	}
+
+	branches, err := c.client.ListBranches()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch branches: %w", err)
+	}
+
+	var issues []Issue
+	for _, branch := range branches {
+		matched := slices.ContainsFunc(globs, func(g glob.Glob) bool { return g.Match(branch.Name) })
+		if !matched {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Type:        c.Type(),
+			Name:        c.Name(),
+			Message:     fmt.Sprintf("Branch '%s' is forbidden", branch.Name),
+			Fixable:     branch.Name != repo.DefaultBranch && !branch.Protected,
+			Destructive: true,
+			Data:        map[string]string{DataKeyBranch: branch.Name},
+		})
+	}
+
+	return issues, nil
+}
+
+// checkStaleBranches reports branches whose last commit is older than the threshold
+// The default branch and protected branches are never reported
+func (c *BranchesCheck) checkStaleBranches(staleAfterDays int) ([]Issue, error) {
+	repo, err := c.client.GetRepository()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch repository: %w", err)
+	}
+
+	branches, err := c.client.ListBranches()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch branches: %w", err)
+	}
+
+	var issues []Issue
+	now := time.Now()
+	cutoff := now.AddDate(0, 0, -staleAfterDays)
+
+	for _, branch := range branches {
+		if branch.Name == repo.DefaultBranch || branch.Protected {
+			continue
+		}
+
+		commit, err := c.client.GetCommit(branch.Commit.SHA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch last commit of branch '%s': %w", branch.Name, err)
+		}
+
+		lastCommit := commit.Commit.Committer.Date
+		if lastCommit.Before(cutoff) {
+			days := int(now.Sub(lastCommit).Hours() / 24)
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Branch '%s' is stale: last commit on %s (%d days ago, threshold %d days)", branch.Name, lastCommit.Format(time.DateOnly), days, staleAfterDays),
+				Fixable: false, // Deleting branches is destructive
+			})
+		}
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,177 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// DefaultBranchRulesetName is the ruleset that fixes for branch_rules create or update
+const DefaultBranchRulesetName = "default-branch"
+
+// BranchRulesCheck validates the rules protecting the default branch against a declarative
+// config, so that teams need not maintain an exported ruleset
+type BranchRulesCheck struct {
+	client  *github.Client
+	config  *config.BranchRulesConfig
+	verbose bool
+}
+
+// NewBranchRulesCheck creates a new branch rules check
+func NewBranchRulesCheck(client *github.Client, cfg *config.BranchRulesConfig, verbose bool) *BranchRulesCheck {
+	return &BranchRulesCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *BranchRulesCheck) Type() CheckType {
+	return CheckTypeBranchRules
+}
+
+// Name returns the check name
+func (c *BranchRulesCheck) Name() string {
+	return "branch_rules"
+}
+
+// Run executes the branch rules check against the rules of every active ruleset that
+// covers the default branch
+func (c *BranchRulesCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil {
+		return nil, nil
+	}
+
+	repo, err := c.client.GetRepository()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch repository: %w", err)
+	}
+
+	rules, err := c.client.GetBranchRules(repo.DefaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rules for branch %s: %w", repo.DefaultBranch, err)
+	}
+
+	var issues []Issue
+	for _, message := range missingBranchRules(c.config, rules) {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Default branch '%s' %s", repo.DefaultBranch, message),
+			Fixable: true,
+			Data:    map[string]string{DataKeyRulesetName: BranchRulesetName(c.config)},
+		})
+	}
+	return issues, nil
+}
+
+// missingBranchRules describes each configured rule that the branch rules do not satisfy
+func missingBranchRules(cfg *config.BranchRulesConfig, rules []github.RulesetRule) []string {
+	var missing []string
+
+	approvals := -1
+	var contexts []string
+	linear := false
+	for _, rule := range rules {
+		switch rule.Type {
+		case "pull_request":
+			count, _ := rule.Parameters["required_approving_review_count"].(float64)
+			approvals = max(approvals, int(count))
+		case "required_linear_history":
+			linear = true
+		case "required_status_checks":
+			statusChecks, _ := rule.Parameters["required_status_checks"].([]any)
+			for _, check := range statusChecks {
+				if m, ok := check.(map[string]any); ok {
+					if name, ok := m["context"].(string); ok {
+						contexts = append(contexts, name)
+					}
+				}
+			}
+		}
+	}
+
+	requirePR := (cfg.RequirePullRequest != nil && *cfg.RequirePullRequest) || cfg.RequiredApprovals != nil
+	switch {
+	case requirePR && approvals < 0:
+		missing = append(missing, "does not require a pull request")
+	case cfg.RequiredApprovals != nil && approvals < *cfg.RequiredApprovals:
+		missing = append(missing, fmt.Sprintf("requires %d approving reviews but should require at least %d", approvals, *cfg.RequiredApprovals))
+	}
+
+	if cfg.RequireLinearHistory != nil && *cfg.RequireLinearHistory && !linear {
+		missing = append(missing, "does not require linear history")
+	}
+
+	for _, name := range cfg.RequireStatusChecks {
+		if !slices.Contains(contexts, name) {
+			missing = append(missing, fmt.Sprintf("does not require status check '%s'", name))
+		}
+	}
+
+	return missing
+}
+
+// BranchRulesetName returns the name of the ruleset managed for branch_rules
+func BranchRulesetName(cfg *config.BranchRulesConfig) string {
+	if cfg.RulesetName != "" {
+		return cfg.RulesetName
+	}
+	return DefaultBranchRulesetName
+}
+
+// BranchRulesetRequest builds an active ruleset on the default branch that enforces branch_rules
+func BranchRulesetRequest(cfg *config.BranchRulesConfig) *github.RulesetCreateRequest {
+	rules := []github.RulesetRule{}
+
+	if (cfg.RequirePullRequest != nil && *cfg.RequirePullRequest) || cfg.RequiredApprovals != nil {
+		approvals := 0
+		if cfg.RequiredApprovals != nil {
+			approvals = *cfg.RequiredApprovals
+		}
+		// The API requires every pull_request parameter to be set
+		rules = append(rules, github.RulesetRule{
+			Type: "pull_request",
+			Parameters: map[string]any{
+				"required_approving_review_count":   approvals,
+				"dismiss_stale_reviews_on_push":     false,
+				"require_code_owner_review":         false,
+				"require_last_push_approval":        false,
+				"required_review_thread_resolution": false,
+			},
+		})
+	}
+
+	if cfg.RequireLinearHistory != nil && *cfg.RequireLinearHistory {
+		rules = append(rules, github.RulesetRule{Type: "required_linear_history"})
+	}
+
+	if len(cfg.RequireStatusChecks) > 0 {
+		statusChecks := make([]map[string]any, 0, len(cfg.RequireStatusChecks))
+		for _, name := range cfg.RequireStatusChecks {
+			statusChecks = append(statusChecks, map[string]any{"context": name})
+		}
+		rules = append(rules, github.RulesetRule{
+			Type: "required_status_checks",
+			Parameters: map[string]any{
+				"required_status_checks":               statusChecks,
+				"strict_required_status_checks_policy": false,
+			},
+		})
+	}
+
+	return &github.RulesetCreateRequest{
+		Name:        BranchRulesetName(cfg),
+		Target:      "branch",
+		Enforcement: "active",
+		Conditions: &github.RulesetConditions{
+			RefName: &github.RefNameCondition{Include: []string{"~DEFAULT_BRANCH"}, Exclude: []string{}},
+		},
+		Rules:        rules,
+		BypassActors: []github.BypassActor{},
+	}
+}
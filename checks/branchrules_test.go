@@ -0,0 +1,68 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestMissingBranchRules(t *testing.T) {
+	boolPtr := func(b bool) *bool { return &b }
+	intPtr := func(i int) *int { return &i }
+
+	pullRequest := func(approvals float64) github.RulesetRule {
+		return github.RulesetRule{Type: "pull_request", Parameters: map[string]any{"required_approving_review_count": approvals}}
+	}
+	statusChecks := github.RulesetRule{Type: "required_status_checks", Parameters: map[string]any{
+		"required_status_checks": []any{map[string]any{"context": "build"}},
+	}}
+
+	tests := []struct {
+		name  string
+		cfg   config.BranchRulesConfig
+		rules []github.RulesetRule
+		want  []string
+	}{
+		{"nothing configured", config.BranchRulesConfig{}, nil, nil},
+		{"pull request missing", config.BranchRulesConfig{RequirePullRequest: boolPtr(true)}, nil, []string{"does not require a pull request"}},
+		{"approvals imply pull request", config.BranchRulesConfig{RequiredApprovals: intPtr(1)}, nil, []string{"does not require a pull request"}},
+		{"too few approvals", config.BranchRulesConfig{RequiredApprovals: intPtr(2)}, []github.RulesetRule{pullRequest(1)}, []string{"requires 1 approving reviews but should require at least 2"}},
+		{"strictest ruleset wins", config.BranchRulesConfig{RequiredApprovals: intPtr(2)}, []github.RulesetRule{pullRequest(0), pullRequest(2)}, nil},
+		{"linear history", config.BranchRulesConfig{RequireLinearHistory: boolPtr(true)}, []github.RulesetRule{{Type: "required_linear_history"}}, nil},
+		{"status checks", config.BranchRulesConfig{RequireStatusChecks: []string{"build", "lint"}}, []github.RulesetRule{statusChecks}, []string{"does not require status check 'lint'"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := missingBranchRules(&tt.cfg, tt.rules); !slices.Equal(got, tt.want) {
+				t.Errorf("missingBranchRules() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBranchRulesetRequest(t *testing.T) {
+	approvals := 1
+	linear := true
+	req := BranchRulesetRequest(&config.BranchRulesConfig{
+		RequiredApprovals:    &approvals,
+		RequireLinearHistory: &linear,
+		RequireStatusChecks:  []string{"build"},
+	})
+
+	if req.Name != DefaultBranchRulesetName || req.Target != "branch" || req.Enforcement != "active" {
+		t.Errorf("BranchRulesetRequest() = %s/%s/%s, want %s/branch/active", req.Name, req.Target, req.Enforcement, DefaultBranchRulesetName)
+	}
+	var types []string
+	for _, rule := range req.Rules {
+		types = append(types, rule.Type)
+	}
+	if want := []string{"pull_request", "required_linear_history", "required_status_checks"}; !slices.Equal(types, want) {
+		t.Errorf("BranchRulesetRequest() rules = %v, want %v", types, want)
+	}
+	if got := req.Rules[0].Parameters["required_approving_review_count"]; got != 1 {
+		t.Errorf("required_approving_review_count = %v, want 1", got)
+	}
+}
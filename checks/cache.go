@@ -0,0 +1,77 @@
+package checks
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// cacheInputs are setup action inputs that enable built-in dependency caching
+// (e.g. cache: npm for actions/setup-node, bundler-cache: true for ruby/setup-ruby)
+var cacheInputs = []string{"cache", "bundler-cache"}
+
+// stepCaches reports whether a step restores a dependency cache, either with
+// actions/cache or with a setup action's built-in caching
+func stepCaches(step github.WorkflowStep) bool {
+	action, _, _ := strings.Cut(step.Uses, "@")
+	if action == "" {
+		return false
+	}
+	if action == "actions/cache" || strings.HasPrefix(action, "actions/cache/") {
+		return true
+	}
+
+	_, name, _ := strings.Cut(action, "/")
+	if !strings.HasPrefix(name, "setup-") {
+		return false
+	}
+	for _, input := range cacheInputs {
+		if value, ok := step.With[input]; ok && value != "" && value != "false" {
+			return true
+		}
+	}
+	// actions/setup-go caches by default since v4
+	if action == "actions/setup-go" {
+		return step.With["cache"] != "false"
+	}
+	return false
+}
+
+// checkDependencyCache reports workflows matching require_dependency_cache that have
+// no caching step in any job
+func (c *ActionsCheck) checkDependencyCache(wfPath string, wf *github.Workflow) ([]Issue, error) {
+	matched := false
+	for _, pattern := range c.config.RequireDependencyCache {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid require_dependency_cache pattern %q: %w", pattern, err)
+		}
+		if g.Match(filepath.Base(wfPath)) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return nil, nil
+	}
+
+	for _, job := range wf.Jobs {
+		for _, step := range job.Steps {
+			if stepCaches(step) && !conditionDisabled(step.If) {
+				return nil, nil
+			}
+		}
+	}
+
+	return []Issue{{
+		Type:    c.Type(),
+		Name:    c.Name(),
+		File:    wfPath,
+		Message: fmt.Sprintf("Workflow '%s' does not cache dependencies (use actions/cache or a setup action's cache input)", wfPath),
+		Fixable: false,
+	}}, nil
+}
@@ -0,0 +1,34 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestStepCaches(t *testing.T) {
+	tests := []struct {
+		name string
+		step github.WorkflowStep
+		want bool
+	}{
+		{"run", github.WorkflowStep{Run: "npm ci"}, false},
+		{"actions/cache", github.WorkflowStep{Uses: "actions/cache@v4"}, true},
+		{"actions/cache/restore", github.WorkflowStep{Uses: "actions/cache/restore@v4"}, true},
+		{"setup-node with cache", github.WorkflowStep{Uses: "actions/setup-node@v4", With: map[string]string{"cache": "npm"}}, true},
+		{"setup-node without cache", github.WorkflowStep{Uses: "actions/setup-node@v4"}, false},
+		{"setup-python cache false", github.WorkflowStep{Uses: "actions/setup-python@v5", With: map[string]string{"cache": "false"}}, false},
+		{"setup-ruby bundler-cache", github.WorkflowStep{Uses: "ruby/setup-ruby@v1", With: map[string]string{"bundler-cache": "true"}}, true},
+		{"setup-go default", github.WorkflowStep{Uses: "actions/setup-go@v5"}, true},
+		{"setup-go disabled", github.WorkflowStep{Uses: "actions/setup-go@v5", With: map[string]string{"cache": "false"}}, false},
+		{"checkout", github.WorkflowStep{Uses: "actions/checkout@v4", With: map[string]string{"cache": "true"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stepCaches(tt.step); got != tt.want {
+				t.Errorf("stepCaches(%+v) = %v, want %v", tt.step, got, tt.want)
+			}
+		})
+	}
+}
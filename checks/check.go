@@ -2,7 +2,15 @@
 package checks
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
 
 	"github.com/sethrylan/gh-repolint/config"
 	"github.com/sethrylan/gh-repolint/github"
@@ -13,29 +21,131 @@ type CheckType string
 
 // Check types for different validation categories
 const (
-	CheckTypeSettings CheckType = "settings"
-	CheckTypeActions  CheckType = "actions"
-	CheckTypeRulesets CheckType = "rulesets"
-	CheckTypeFiles    CheckType = "files"
+	CheckTypeSettings     CheckType = "settings"
+	CheckTypeActions      CheckType = "actions"
+	CheckTypeRulesets     CheckType = "rulesets"
+	CheckTypeFiles        CheckType = "files"
+	CheckTypeAutolinks    CheckType = "autolinks"
+	CheckTypeBranches     CheckType = "branches"
+	CheckTypeFunding      CheckType = "funding"
+	CheckTypeLabels       CheckType = "labels"
+	CheckTypeTopics       CheckType = "topics"
+	CheckTypeLanguages    CheckType = "languages"
+	CheckTypeTemplates    CheckType = "template_structure"
+	CheckTypeBranchRules  CheckType = "branch_rules"
+	CheckTypeReadme       CheckType = "readme"
+	CheckTypeCheckRuns    CheckType = "check_runs"
+	CheckTypeConsistency  CheckType = "consistency"
+	CheckTypeOrganization CheckType = "organization"
+	CheckTypeDependabot   CheckType = "dependabot"
+	CheckTypeCustom       CheckType = "custom"
 )
 
+// LocalCheckTypes are the check types that inspect files in the local working tree
+var LocalCheckTypes = []CheckType{CheckTypeActions, CheckTypeFiles, CheckTypeFunding, CheckTypeDependabot, CheckTypeTemplates, CheckTypeReadme, CheckTypeCustom}
+
 // Data keys for passing structured data from checks to fixers
 const (
 	DataKeyFileName    = "file_name"
 	DataKeyReference   = "reference"
 	DataKeyRulesetName = "ruleset_name"
 	DataKeySetting     = "setting"
+	DataKeyEnforcement = "enforcement"
+	DataKeyKeyPrefix   = "key_prefix"
+	DataKeyLabel       = "label"
+	DataKeyBranch      = "branch"
+	DataKeyCustomCheck = "custom_check"
+	DataKeyTopic       = "topic"
+	DataKeyWorkflow    = "workflow"
+	// DataKeyTopicAction is TopicActionAdd or TopicActionRemove
+	DataKeyTopicAction = "topic_action"
+)
+
+// Topic actions a fixer applies for a topics issue
+const (
+	TopicActionAdd    = "add"
+	TopicActionRemove = "remove"
+)
+
+// docsURL is the base URL of the gh-repolint documentation
+const docsURL = "https://github.com/sethrylan/gh-repolint"
+
+// Severity indicates how serious an issue is
+type Severity int
+
+// Severity levels; the zero value is SeverityError
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+	SeverityInfo
 )
 
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	default:
+		return "unknown"
+	}
+}
+
 // Issue represents a linting issue found during a check
 type Issue struct {
 	Type    CheckType // The check type (e.g., CheckTypeFiles, CheckTypeSettings)
 	Name    string    // The specific check name (e.g., "files(.github/dependabot.yml)")
 	Message string
 	Fixable bool
-	Data    map[string]string // Structured data for fixers (e.g., file name, reference)
+	// Destructive marks fixes that delete data; they are only applied with --allow-destructive
+	Destructive bool
+	Severity    Severity          // Defaults to SeverityError
+	HelpURL     string            // Documentation for the policy; filled in by the Runner
+	File        string            // Local file the issue refers to, if file-scoped
+	Line        int               // 1-based line in File, or 0 if unknown
+	Data        map[string]string // Structured data for fixers (e.g., file name, reference)
+}
+
+// Fingerprint identifies an issue across runs. It covers the check, file and message but
+// not the line, so that an issue keeps its fingerprint when unrelated lines move.
+func (i Issue) Fingerprint() string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{string(i.Type), i.Name, i.File, i.Message}, "\x00")))
+	return hex.EncodeToString(sum[:8])
 }
 
+// ErrorCount returns the number of issues with SeverityError
+func ErrorCount(issues []Issue) int {
+	count := 0
+	for _, issue := range issues {
+		if issue.Severity == SeverityError {
+			count++
+		}
+	}
+	return count
+}
+
+// FixableIssues returns the issues that --fix would attempt to fix
+func FixableIssues(issues []Issue) []Issue {
+	var fixable []Issue
+	for _, issue := range issues {
+		if issue.Fixable {
+			fixable = append(fixable, issue)
+		}
+	}
+	return fixable
+}
+
+// lineNumber returns the 1-based line number of the byte offset in content
+func lineNumber(content string, offset int) int {
+	return strings.Count(content[:offset], "\n") + 1
+}
+
+// ErrCheckNotRun is returned by a check that chose not to run, such as a custom check
+// without --allow-exec; the Runner records it as skipped rather than failed
+var ErrCheckNotRun = errors.New("check not run")
+
 // Check is the interface that all checks must implement
 type Check interface {
 	Type() CheckType // Returns the check type (e.g., CheckTypeFiles)
@@ -48,7 +158,11 @@ type Runner struct {
 	client  *github.Client
 	config  *config.Config
 	checks  []Check
-	verbose bool
+	skipped map[string]bool // Checks skipped by the last Run
+	// errors holds the checks that failed in the last Run with continueOnError, by name
+	errors          map[string]error
+	continueOnError bool
+	verbose         bool
 }
 
 // NewRunner creates a new check runner
@@ -70,14 +184,129 @@ func NewRunner(client *github.Client, cfg *config.Config, verbose bool) *Runner
 		runner.checks = append(runner.checks, NewRulesetsCheck(client, &rs, verbose))
 	}
 
+	// Add ruleset set check
+	if cfg.Checks.RulesetSet != nil {
+		runner.checks = append(runner.checks, NewRulesetSetCheck(client, cfg.Checks.RulesetSet, verbose))
+	}
+
+	// Add branch rules check
+	if cfg.Checks.BranchRules != nil {
+		runner.checks = append(runner.checks, NewBranchRulesCheck(client, cfg.Checks.BranchRules, verbose))
+	}
+
 	// Add file checks
 	for _, f := range cfg.Checks.Files {
 		runner.checks = append(runner.checks, NewFilesCheck(client, &f, verbose))
 	}
 
+	// Add autolinks check
+	if len(cfg.Checks.Autolinks) > 0 {
+		runner.checks = append(runner.checks, NewAutolinksCheck(client, cfg.Checks.Autolinks, verbose))
+	}
+
+	// Add labels check
+	if len(cfg.Checks.Labels) > 0 {
+		runner.checks = append(runner.checks, NewLabelsCheck(client, cfg.Checks.Labels, verbose))
+	}
+
+	// Add topics check
+	if cfg.Checks.Topics != nil {
+		runner.checks = append(runner.checks, NewTopicsCheck(client, cfg.Checks.Topics, verbose))
+	}
+
+	// Add languages check
+	if cfg.Checks.Languages != nil {
+		runner.checks = append(runner.checks, NewLanguagesCheck(client, cfg.Checks.Languages, verbose))
+	}
+
+	// Add template structure check
+	if cfg.Checks.TemplateStructure != nil {
+		runner.checks = append(runner.checks, NewTemplateStructureCheck(client, cfg.Checks.TemplateStructure, verbose))
+	}
+
+	// Add readme check
+	if cfg.Checks.Readme != nil {
+		runner.checks = append(runner.checks, NewReadmeCheck(client, cfg.Checks.Readme, verbose))
+	}
+
+	// Add check runs check
+	if cfg.Checks.CheckRuns != nil {
+		runner.checks = append(runner.checks, NewCheckRunsCheck(client, cfg.Checks.CheckRuns, verbose))
+	}
+
+	// Add branches check
+	if cfg.Checks.Branches != nil {
+		runner.checks = append(runner.checks, NewBranchesCheck(client, cfg.Checks.Branches, verbose))
+	}
+
+	// Add funding check
+	if cfg.Checks.Funding != nil {
+		runner.checks = append(runner.checks, NewFundingCheck(client, cfg.Checks.Funding, verbose))
+	}
+
+	// Add dependabot check
+	if cfg.Checks.Dependabot != nil {
+		runner.checks = append(runner.checks, NewDependabotCheck(client, cfg.Checks.Dependabot, verbose))
+	}
+
+	// Add custom checks
+	for _, cc := range cfg.Checks.Custom {
+		runner.checks = append(runner.checks, NewCustomCheck(client, &cc, verbose))
+	}
+
+	// Add cross-setting consistency check
+	if needsConsistencyCheck(cfg) {
+		runner.checks = append(runner.checks, NewConsistencyCheck(client, cfg, verbose))
+	}
+
 	return runner
 }
 
+// needsConsistencyCheck reports whether the configuration sets any of the settings
+// that the consistency check correlates
+func needsConsistencyCheck(cfg *config.Config) bool {
+	settings := cfg.Checks.Settings
+	if settings == nil {
+		return false
+	}
+	if settings.DefaultBranch != "" && len(cfg.Checks.Rulesets) > 0 {
+		return true
+	}
+	return settings.Merge != nil && settings.Merge.AllowAutoMerge != nil && *settings.Merge.AllowAutoMerge
+}
+
+// SetContinueOnError makes Run record a failing check's error and run the remaining
+// checks, instead of returning the first error
+func (r *Runner) SetContinueOnError(enabled bool) {
+	r.continueOnError = enabled
+}
+
+// SetCheckLinks enables network requests that confirm URLs in repository settings
+// resolve, for the checks that support it
+func (r *Runner) SetCheckLinks(enabled bool) {
+	for _, check := range r.checks {
+		if lc, ok := check.(interface{ SetCheckLinks(bool) }); ok {
+			lc.SetCheckLinks(enabled)
+		}
+	}
+}
+
+// SetAllowExec allows custom checks to run their external commands
+func (r *Runner) SetAllowExec(allowed bool) {
+	for _, check := range r.checks {
+		if ec, ok := check.(interface{ SetAllowExec(bool) }); ok {
+			ec.SetAllowExec(allowed)
+		}
+	}
+}
+
+// ExcludeTypes removes all checks of the given types from the runner
+func (r *Runner) ExcludeTypes(types ...CheckType) {
+	r.checks = slices.DeleteFunc(r.checks, func(check Check) bool {
+		return slices.Contains(types, check.Type())
+	})
+}
+
 // Run executes all enabled checks and returns all issues found
 func (r *Runner) Run(ctx context.Context, skip []string) ([]Issue, error) {
 	var allIssues []Issue
@@ -86,6 +315,8 @@ func (r *Runner) Run(ctx context.Context, skip []string) ([]Issue, error) {
 	for _, s := range skip {
 		skipMap[s] = true
 	}
+	r.skipped = skipMap
+	r.errors = make(map[string]error)
 
 	for _, check := range r.checks {
 
@@ -94,16 +325,65 @@ func (r *Runner) Run(ctx context.Context, skip []string) ([]Issue, error) {
 		}
 
 		issues, err := check.Run(ctx)
+		if errors.Is(err, ErrCheckNotRun) {
+			r.skipped[check.Name()] = true
+			fmt.Fprintf(os.Stderr, "Skipped %s: %v\n", check.Name(), err)
+			continue
+		}
 		if err != nil {
-			return nil, err
+			if !r.continueOnError {
+				return nil, err
+			}
+			r.errors[check.Name()] = err
+			continue
 		}
 
+		for i := range issues {
+			if issues[i].HelpURL == "" {
+				issues[i].HelpURL = r.helpURL(issues[i].Type)
+			}
+		}
+		sortIssues(issues)
+
 		allIssues = append(allIssues, issues...)
 	}
 
 	return allIssues, nil
 }
 
+// sortIssues orders a check's issues by file and line, then by message within the same
+// file, so that output is stable across runs. Issues without a file come first, in the
+// order the check reported them.
+func sortIssues(issues []Issue) {
+	slices.SortStableFunc(issues, func(a, b Issue) int {
+		if c := cmp.Compare(a.File, b.File); c != 0 {
+			return c
+		}
+		if a.File == "" {
+			return 0
+		}
+		if c := cmp.Compare(a.Line, b.Line); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Message, b.Message)
+	})
+}
+
+// helpURL returns the configured documentation link for a check type, defaulting
+// to the check's section of the README
+func (r *Runner) helpURL(checkType CheckType) string {
+	if url := r.config.Checks.HelpURLs[string(checkType)]; url != "" {
+		return url
+	}
+	return DefaultHelpURL(checkType)
+}
+
+// DefaultHelpURL returns the README section documenting a check type. Headings such as
+// "Check Runs Check" have hyphenated anchors, while check types use underscores.
+func DefaultHelpURL(checkType CheckType) string {
+	return fmt.Sprintf("%s#%s-check", docsURL, strings.ReplaceAll(string(checkType), "_", "-"))
+}
+
 // GetCheckNames returns the names of all available checks
 func (r *Runner) GetCheckNames() []string {
 	names := make([]string, 0, len(r.checks))
@@ -113,19 +393,99 @@ func (r *Runner) GetCheckNames() []string {
 	return names
 }
 
+// GetCheckTypes returns the distinct types of all available checks, in run order
+func (r *Runner) GetCheckTypes() []CheckType {
+	var types []CheckType
+	for _, check := range r.checks {
+		if !slices.Contains(types, check.Type()) {
+			types = append(types, check.Type())
+		}
+	}
+	return types
+}
+
+// ValidateCheckNames returns an error for the first of names that is not a known check
+// name, suggesting the closest known name when one is plausibly what was meant
+func ValidateCheckNames(names, known []string) error {
+	for _, name := range names {
+		if name == "" || slices.Contains(known, name) {
+			continue
+		}
+		if suggestion := suggestCheckName(name, known); suggestion != "" {
+			return fmt.Errorf("unknown check '%s' (did you mean '%s'?)", name, suggestion)
+		}
+		if len(known) == 0 {
+			return fmt.Errorf("unknown check '%s' (no checks are configured)", name)
+		}
+		return fmt.Errorf("unknown check '%s' (configured checks: %s)", name, strings.Join(known, ", "))
+	}
+	return nil
+}
+
+// suggestCheckName returns the known name that name most likely misspells: the first
+// known name it is a prefix of (e.g. "rulesets" for "rulesets(main)"), or else the
+// closest by edit distance when at most a third of name differs. It returns "" when
+// nothing is close.
+func suggestCheckName(name string, known []string) string {
+	lower := strings.ToLower(name)
+	for _, candidate := range known {
+		if strings.HasPrefix(strings.ToLower(candidate), lower) {
+			return candidate
+		}
+	}
+
+	best, bestDistance := "", max(1, len(name)/3)+1
+	for _, candidate := range known {
+		if d := levenshtein(lower, strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the number of single-character insertions, deletions and
+// substitutions needed to turn a into b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
 // CheckStatus represents the status of a check
 type CheckStatus struct {
 	Name    string
 	Skipped bool
+	// Error is set when the check failed to run with continue-on-error
+	Error string
 }
 
 // GetCheckStatuses returns the status of all checks
 func (r *Runner) GetCheckStatuses() []CheckStatus {
 	statuses := make([]CheckStatus, 0, len(r.checks))
 	for _, check := range r.checks {
-		statuses = append(statuses, CheckStatus{
-			Name: check.Name(),
-		})
+		status := CheckStatus{
+			Name:    check.Name(),
+			Skipped: r.skipped[check.Name()],
+		}
+		if err := r.errors[check.Name()]; err != nil {
+			status.Error = err.Error()
+		}
+		statuses = append(statuses, status)
 	}
 	return statuses
 }
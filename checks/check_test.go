@@ -0,0 +1,172 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/config"
+)
+
+func TestSortIssues(t *testing.T) {
+	issues := []Issue{
+		{File: ".github/workflows/b.yml", Message: "Job 'a' in b"},
+		{Message: "Wiki is enabled but should be disabled"},
+		{File: ".github/workflows/a.yml", Line: 12, Message: "unpinned"},
+		{File: ".github/workflows/a.yml", Message: "Job 'z' in a"},
+		{File: ".github/workflows/a.yml", Message: "Job 'b' in a"},
+		{Message: "Issues is enabled but should be disabled"},
+	}
+
+	sortIssues(issues)
+
+	want := []string{
+		"Wiki is enabled but should be disabled",
+		"Issues is enabled but should be disabled",
+		"Job 'b' in a",
+		"Job 'z' in a",
+		"unpinned",
+		"Job 'a' in b",
+	}
+	for i, issue := range issues {
+		if issue.Message != want[i] {
+			t.Errorf("issues[%d] = %q, want %q", i, issue.Message, want[i])
+		}
+	}
+}
+
+func TestIssueFingerprint(t *testing.T) {
+	issue := Issue{Type: CheckTypeActions, Name: "actions", File: ".github/workflows/ci.yml", Line: 10, Message: "Action 'foo/bar@v1' is not pinned"}
+
+	moved := issue
+	moved.Line = 42
+	if issue.Fingerprint() != moved.Fingerprint() {
+		t.Errorf("fingerprint should not depend on the line")
+	}
+
+	other := issue
+	other.Message = "Action 'foo/baz@v1' is not pinned"
+	if issue.Fingerprint() == other.Fingerprint() {
+		t.Errorf("fingerprint should depend on the message")
+	}
+}
+
+func TestDefaultHelpURL(t *testing.T) {
+	tests := []struct {
+		checkType CheckType
+		want      string
+	}{
+		{CheckTypeSettings, docsURL + "#settings-check"},
+		{CheckTypeCheckRuns, docsURL + "#check-runs-check"},
+		{CheckTypeTemplates, docsURL + "#template-structure-check"},
+	}
+
+	for _, tt := range tests {
+		if got := DefaultHelpURL(tt.checkType); got != tt.want {
+			t.Errorf("DefaultHelpURL(%q) = %q, want %q", tt.checkType, got, tt.want)
+		}
+	}
+}
+
+func TestFixableIssues(t *testing.T) {
+	issues := []Issue{
+		{Message: "a", Fixable: true},
+		{Message: "b"},
+		{Message: "c", Fixable: true, Destructive: true},
+	}
+
+	fixable := FixableIssues(issues)
+	if len(fixable) != 2 || fixable[0].Message != "a" || fixable[1].Message != "c" {
+		t.Errorf("FixableIssues() = %v, want issues a and c", fixable)
+	}
+	if got := FixableIssues([]Issue{{Message: "b"}}); len(got) != 0 {
+		t.Errorf("FixableIssues() = %v, want none", got)
+	}
+}
+
+func TestValidateCheckNames(t *testing.T) {
+	known := []string{"settings", "actions", "rulesets(main)", "files(.github/dependabot.yml)"}
+
+	tests := []struct {
+		name    string
+		skip    []string
+		wantErr string
+	}{
+		{"known names", []string{"settings", "rulesets(main)"}, ""},
+		{"empty name", []string{""}, ""},
+		{"prefix", []string{"ruleset"}, "unknown check 'ruleset' (did you mean 'rulesets(main)'?)"},
+		{"typo", []string{"setings"}, "unknown check 'setings' (did you mean 'settings'?)"},
+		{"unrelated", []string{"labels"}, "unknown check 'labels' (configured checks: settings, actions, rulesets(main), files(.github/dependabot.yml))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateCheckNames(tt.skip, known)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateCheckNames() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateCheckNames() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// stubCheck is a check that returns fixed issues or an error
+type stubCheck struct {
+	name   string
+	issues []Issue
+	err    error
+}
+
+func (c stubCheck) Type() CheckType                      { return CheckTypeCustom }
+func (c stubCheck) Name() string                         { return c.name }
+func (c stubCheck) Run(context.Context) ([]Issue, error) { return c.issues, c.err }
+
+func TestRunnerContinueOnError(t *testing.T) {
+	newRunner := func() *Runner {
+		return &Runner{config: &config.Config{}, checks: []Check{
+			stubCheck{name: "first", err: errors.New("transient API error")},
+			stubCheck{name: "second", issues: []Issue{{Message: "found"}}},
+		}}
+	}
+
+	if _, err := newRunner().Run(context.Background(), nil); err == nil {
+		t.Fatal("Run() should fail fast by default")
+	}
+
+	runner := newRunner()
+	runner.SetContinueOnError(true)
+	issues, err := runner.Run(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(issues) != 1 || issues[0].Message != "found" {
+		t.Errorf("Run() = %v, want the second check's issue", issues)
+	}
+	statuses := runner.GetCheckStatuses()
+	if statuses[0].Error != "transient API error" || statuses[1].Error != "" {
+		t.Errorf("GetCheckStatuses() = %+v, want only the first check errored", statuses)
+	}
+}
+
+func TestRunnerCheckNotRun(t *testing.T) {
+	runner := &Runner{config: &config.Config{}, checks: []Check{
+		stubCheck{name: "custom(policy)", err: fmt.Errorf("%w (use --allow-exec)", ErrCheckNotRun)},
+	}}
+
+	issues, err := runner.Run(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("Run() = %v, want no issues", issues)
+	}
+	if statuses := runner.GetCheckStatuses(); !statuses[0].Skipped || statuses[0].Error != "" {
+		t.Errorf("GetCheckStatuses() = %+v, want the check skipped", statuses)
+	}
+}
@@ -0,0 +1,75 @@
+package checks
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// defaultFetchDepth is the fetch-depth actions/checkout uses when none is given
+const defaultFetchDepth = 1
+
+// checkoutFetchDepth returns the fetch-depth of an actions/checkout step. ok is false for
+// other steps and for depths that cannot be evaluated statically, such as expressions.
+func checkoutFetchDepth(step github.WorkflowStep) (depth int, ok bool) {
+	action, _, _ := strings.Cut(step.Uses, "@")
+	if action != "actions/checkout" {
+		return 0, false
+	}
+	value, set := step.With["fetch-depth"]
+	if !set || value == "" {
+		return defaultFetchDepth, true
+	}
+	depth, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil {
+		return 0, false
+	}
+	return depth, true
+}
+
+// checkFetchDepth reports actions/checkout steps whose fetch-depth differs from the first
+// checkout_fetch_depth rule matching the workflow file name
+func (c *ActionsCheck) checkFetchDepth(wfPath string, wf *github.Workflow) ([]Issue, error) {
+	required := -1
+	for _, rule := range c.config.CheckoutFetchDepth {
+		g, err := glob.Compile(rule.Workflows)
+		if err != nil {
+			return nil, fmt.Errorf("invalid checkout_fetch_depth workflows pattern %q: %w", rule.Workflows, err)
+		}
+		if g.Match(filepath.Base(wfPath)) {
+			required = rule.FetchDepth
+			break
+		}
+	}
+	if required < 0 {
+		return nil, nil
+	}
+
+	var issues []Issue
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		for i, step := range wf.Jobs[jobName].Steps {
+			depth, ok := checkoutFetchDepth(step)
+			if !ok || depth == required || conditionDisabled(step.If) {
+				continue
+			}
+			stepName := step.Name
+			if stepName == "" {
+				stepName = fmt.Sprintf("#%d", i+1)
+			}
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: fmt.Sprintf("Job '%s' step '%s' in '%s' checks out with fetch-depth %d but should use %d", jobName, stepName, wfPath, depth, required),
+				Fixable: false,
+			})
+		}
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,32 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestCheckoutFetchDepth(t *testing.T) {
+	tests := []struct {
+		name      string
+		step      github.WorkflowStep
+		wantDepth int
+		wantOK    bool
+	}{
+		{"default depth", github.WorkflowStep{Uses: "actions/checkout@v4"}, 1, true},
+		{"full history", github.WorkflowStep{Uses: "actions/checkout@v4", With: map[string]string{"fetch-depth": "0"}}, 0, true},
+		{"explicit depth", github.WorkflowStep{Uses: "actions/checkout@abc123", With: map[string]string{"fetch-depth": "50"}}, 50, true},
+		{"expression", github.WorkflowStep{Uses: "actions/checkout@v4", With: map[string]string{"fetch-depth": "${{ inputs.depth }}"}}, 0, false},
+		{"other action", github.WorkflowStep{Uses: "actions/setup-go@v5"}, 0, false},
+		{"run step", github.WorkflowStep{Run: "git fetch --unshallow"}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			depth, ok := checkoutFetchDepth(tt.step)
+			if depth != tt.wantDepth || ok != tt.wantOK {
+				t.Errorf("checkoutFetchDepth(%+v) = %d, %v, want %d, %v", tt.step, depth, ok, tt.wantDepth, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// CheckRunsCheck validates that required check runs, such as those reported by external
+// apps, have reported on the default branch
+type CheckRunsCheck struct {
+	client  *github.Client
+	config  *config.CheckRunsConfig
+	verbose bool
+}
+
+// NewCheckRunsCheck creates a new check runs check
+func NewCheckRunsCheck(client *github.Client, cfg *config.CheckRunsConfig, verbose bool) *CheckRunsCheck {
+	return &CheckRunsCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *CheckRunsCheck) Type() CheckType {
+	return CheckTypeCheckRuns
+}
+
+// Name returns the check name
+func (c *CheckRunsCheck) Name() string {
+	return "check_runs"
+}
+
+// Run executes the check runs check
+func (c *CheckRunsCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil || len(c.config.Required) == 0 {
+		return nil, nil
+	}
+
+	repo, err := c.client.GetRepository()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch repository: %w", err)
+	}
+
+	runs, err := c.client.GetCheckRuns(repo.DefaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch check runs for %s: %w", repo.DefaultBranch, err)
+	}
+
+	var issues []Issue
+	for _, want := range c.config.Required {
+		problem := checkRunProblem(want, runs)
+		if problem == "" {
+			continue
+		}
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Required check run '%s' %s on the latest commit of default branch '%s'", want.Name, problem, repo.DefaultBranch),
+			Fixable: false,
+		})
+	}
+
+	return issues, nil
+}
+
+// checkRunProblem describes why no run in runs satisfies the required check run, or
+// returns "" if one does. A run satisfies it when its name matches and, if the
+// requirement names an app, it was reported by that app.
+func checkRunProblem(want config.CheckRunConfig, runs []github.CheckRun) string {
+	var otherApps []string
+	for _, run := range runs {
+		if run.Name != want.Name {
+			continue
+		}
+		if want.App == "" || run.App.Slug == want.App {
+			return ""
+		}
+		otherApps = append(otherApps, run.App.Slug)
+	}
+
+	if len(otherApps) > 0 {
+		return fmt.Sprintf("was reported by app '%s', not '%s',", strings.Join(otherApps, "', '"), want.App)
+	}
+	if want.App != "" {
+		return fmt.Sprintf("from app '%s' has not reported", want.App)
+	}
+	return "has not reported"
+}
@@ -0,0 +1,35 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestCheckRunProblem(t *testing.T) {
+	runs := []github.CheckRun{
+		{Name: "build", App: github.CheckRunApp{Slug: "github-actions"}},
+		{Name: "SonarCloud Code Analysis", App: github.CheckRunApp{Slug: "sonarqubecloud"}},
+	}
+
+	tests := []struct {
+		name        string
+		want        config.CheckRunConfig
+		wantProblem string
+	}{
+		{"reported", config.CheckRunConfig{Name: "build"}, ""},
+		{"reported by app", config.CheckRunConfig{Name: "SonarCloud Code Analysis", App: "sonarqubecloud"}, ""},
+		{"never ran", config.CheckRunConfig{Name: "codecov/patch"}, "has not reported"},
+		{"never ran from app", config.CheckRunConfig{Name: "codecov/patch", App: "codecov"}, "from app 'codecov' has not reported"},
+		{"other app", config.CheckRunConfig{Name: "build", App: "circleci-checks"}, "was reported by app 'github-actions', not 'circleci-checks',"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := checkRunProblem(tt.want, runs); got != tt.wantProblem {
+				t.Errorf("checkRunProblem() = %q, want %q", got, tt.wantProblem)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package checks
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// deprecatedCommandRegex matches the ::set-output and ::save-state workflow commands
+var deprecatedCommandRegex = regexp.MustCompile(`::(set-output|save-state)\b`)
+
+// deprecatedCommandReplacements are the environment files that replace each deprecated command
+var deprecatedCommandReplacements = map[string]string{
+	"set-output": `echo "name=value" >> "$GITHUB_OUTPUT"`,
+	"save-state": `echo "name=value" >> "$GITHUB_STATE"`,
+}
+
+// deprecatedCommands returns the deprecated workflow commands used in a step's run
+// script, each once, in order of first use
+func deprecatedCommands(run string) []string {
+	var commands []string
+	seen := make(map[string]bool)
+	for _, match := range deprecatedCommandRegex.FindAllStringSubmatch(run, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			commands = append(commands, match[1])
+		}
+	}
+	return commands
+}
+
+// checkDeprecatedCommands reports steps whose run script uses ::set-output or ::save-state,
+// which GitHub has deprecated in favor of the $GITHUB_OUTPUT and $GITHUB_STATE files
+func (c *ActionsCheck) checkDeprecatedCommands(wfPath string, wf *github.Workflow) []Issue {
+	var issues []Issue
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		for i, step := range wf.Jobs[jobName].Steps {
+			if conditionDisabled(step.If) {
+				continue
+			}
+			stepName := step.Name
+			if stepName == "" {
+				stepName = step.ID
+			}
+			if stepName == "" {
+				stepName = fmt.Sprintf("#%d", i+1)
+			}
+			for _, command := range deprecatedCommands(step.Run) {
+				issues = append(issues, Issue{
+					Type:    c.Type(),
+					Name:    c.Name(),
+					File:    wfPath,
+					Message: fmt.Sprintf("Job '%s' step '%s' in '%s' uses the deprecated ::%s command; use %s instead", jobName, stepName, wfPath, command, deprecatedCommandReplacements[command]),
+					Fixable: false,
+				})
+			}
+		}
+	}
+	return issues
+}
@@ -0,0 +1,29 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeprecatedCommands(t *testing.T) {
+	tests := []struct {
+		name string
+		run  string
+		want []string
+	}{
+		{"set-output", `echo "::set-output name=version::1.2.3"`, []string{"set-output"}},
+		{"save-state", `echo "::save-state name=pid::$PID"`, []string{"save-state"}},
+		{"both, repeated", "echo '::save-state name=a::1'\necho '::set-output name=b::2'\necho '::save-state name=c::3'", []string{"save-state", "set-output"}},
+		{"environment files", `echo "version=1.2.3" >> "$GITHUB_OUTPUT"`, nil},
+		{"other command", `echo "::add-mask::$TOKEN"`, nil},
+		{"empty", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deprecatedCommands(tt.run); !slices.Equal(got, tt.want) {
+				t.Errorf("deprecatedCommands(%q) = %v, want %v", tt.run, got, tt.want)
+			}
+		})
+	}
+}
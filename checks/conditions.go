@@ -0,0 +1,41 @@
+package checks
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// conditionDisabled reports whether an if: condition can never be true: the literal false,
+// with or without ${{ }}. Other expressions cannot be evaluated and are assumed to run.
+func conditionDisabled(condition string) bool {
+	condition = strings.TrimSpace(condition)
+	if inner, ok := strings.CutPrefix(condition, "${{"); ok {
+		if inner, ok = strings.CutSuffix(inner, "}}"); ok {
+			condition = strings.TrimSpace(inner)
+		}
+	}
+	return condition == "false"
+}
+
+// conditionNote annotates a finding with the if: condition guarding it, or returns "" when
+// it is unconditional, so reviewers can see when a finding only applies to some runs
+func conditionNote(condition string) string {
+	if condition = strings.TrimSpace(condition); condition == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (if: %s)", condition)
+}
+
+// enabledJobs returns a copy of the workflow without the jobs that can never run, so
+// that rules are not evaluated against them
+func enabledJobs(wf *github.Workflow) *github.Workflow {
+	enabled := *wf
+	enabled.Jobs = maps.Clone(wf.Jobs)
+	maps.DeleteFunc(enabled.Jobs, func(_ string, job github.WorkflowJob) bool {
+		return conditionDisabled(job.If)
+	})
+	return &enabled
+}
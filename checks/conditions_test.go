@@ -0,0 +1,50 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestConditionDisabled(t *testing.T) {
+	tests := []struct {
+		condition string
+		want      bool
+	}{
+		{"false", true},
+		{"${{ false }}", true},
+		{"${{false}}", true},
+		{"  false ", true},
+		{"", false},
+		{"true", false},
+		{"github.event_name == 'workflow_dispatch'", false},
+		{"${{ false && github.ref == 'refs/heads/main' }}", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.condition, func(t *testing.T) {
+			if got := conditionDisabled(tt.condition); got != tt.want {
+				t.Errorf("conditionDisabled(%q) = %v, want %v", tt.condition, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnabledJobs(t *testing.T) {
+	wf := &github.Workflow{Jobs: map[string]github.WorkflowJob{
+		"build":    {},
+		"disabled": {If: "${{ false }}"},
+		"dispatch": {If: "github.event_name == 'workflow_dispatch'"},
+	}}
+
+	enabled := enabledJobs(wf)
+	if _, ok := enabled.Jobs["disabled"]; ok {
+		t.Error("enabledJobs() should drop jobs with if: false")
+	}
+	if len(enabled.Jobs) != 2 {
+		t.Errorf("enabledJobs() kept %d jobs, want 2", len(enabled.Jobs))
+	}
+	if len(wf.Jobs) != 3 {
+		t.Error("enabledJobs() should not modify the workflow")
+	}
+}
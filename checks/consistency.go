@@ -0,0 +1,162 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// commonDefaultBranchNames are branch names that, when targeted literally by a ruleset,
+// are assumed to be meant as the default branch
+var commonDefaultBranchNames = []string{"main", "master", "trunk", "develop"}
+
+// ConsistencyCheck correlates settings that are otherwise validated in isolation
+type ConsistencyCheck struct {
+	client  *github.Client
+	config  *config.Config
+	verbose bool
+}
+
+// NewConsistencyCheck creates a new consistency check
+func NewConsistencyCheck(client *github.Client, cfg *config.Config, verbose bool) *ConsistencyCheck {
+	return &ConsistencyCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *ConsistencyCheck) Type() CheckType {
+	return CheckTypeConsistency
+}
+
+// Name returns the check name
+func (c *ConsistencyCheck) Name() string {
+	return "consistency"
+}
+
+// Run executes the consistency check
+func (c *ConsistencyCheck) Run(ctx context.Context) ([]Issue, error) {
+	settings := c.config.Checks.Settings
+	if settings == nil {
+		return nil, nil
+	}
+
+	var issues []Issue
+
+	if settings.DefaultBranch != "" && len(c.config.Checks.Rulesets) > 0 {
+		rulesetIssues, err := c.checkDefaultBranchRulesets(settings.DefaultBranch)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, rulesetIssues...)
+	}
+
+	if settings.Merge != nil && settings.Merge.AllowAutoMerge != nil && *settings.Merge.AllowAutoMerge {
+		autoMergeIssues, err := c.checkAutoMergeGated()
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, autoMergeIssues...)
+	}
+
+	return issues, nil
+}
+
+// checkAutoMergeGated verifies that, with auto-merge allowed, the default branch requires
+// at least one status check, so that auto-merged pull requests cannot merge before CI
+func (c *ConsistencyCheck) checkAutoMergeGated() ([]Issue, error) {
+	repo, err := c.client.GetRepository()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	rules, err := c.client.GetBranchRules(repo.DefaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rules for branch %s: %w", repo.DefaultBranch, err)
+	}
+	if hasRequiredStatusChecksRule(rules) {
+		return nil, nil
+	}
+
+	protected, err := c.client.HasRequiredStatusChecks(repo.DefaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch protection for %s: %w", repo.DefaultBranch, err)
+	}
+	if protected {
+		return nil, nil
+	}
+
+	return []Issue{{
+		Type:     c.Type(),
+		Name:     c.Name(),
+		Message:  fmt.Sprintf("allow_auto_merge is enabled but no ruleset or branch protection requires status checks on '%s'", repo.DefaultBranch),
+		Fixable:  false,
+		Severity: SeverityWarning,
+	}}, nil
+}
+
+// hasRequiredStatusChecksRule reports whether any rule requires at least one status check
+func hasRequiredStatusChecksRule(rules []github.RulesetRule) bool {
+	for _, rule := range rules {
+		if rule.Type != "required_status_checks" {
+			continue
+		}
+		if checks, ok := rule.Parameters["required_status_checks"].([]any); ok && len(checks) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDefaultBranchRulesets verifies that branch rulesets targeting a default-like branch
+// by name target the configured default branch, rather than e.g. a stale "master"
+func (c *ConsistencyCheck) checkDefaultBranchRulesets(defaultBranch string) ([]Issue, error) {
+	g, err := glob.Compile(defaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("invalid default_branch pattern: %w", err)
+	}
+
+	var issues []Issue
+
+	for _, rs := range c.config.Checks.Rulesets {
+		if rs.Reference == "" {
+			continue
+		}
+
+		ruleset, err := github.FetchReferenceRuleset(rs.Reference, c.client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reference ruleset: %w", err)
+		}
+
+		if ruleset.Target != "" && ruleset.Target != "branch" {
+			continue
+		}
+		if ruleset.Conditions == nil || ruleset.Conditions.RefName == nil {
+			continue
+		}
+
+		for _, include := range ruleset.Conditions.RefName.Include {
+			branch := strings.TrimPrefix(include, "refs/heads/")
+			if !slices.Contains(commonDefaultBranchNames, branch) || g.Match(branch) {
+				continue
+			}
+			issues = append(issues, Issue{
+				Type: c.Type(),
+				Name: c.Name(),
+				Message: fmt.Sprintf("Ruleset '%s' targets '%s' but default_branch is '%s' (use ~DEFAULT_BRANCH)",
+					rs.Name, include, defaultBranch),
+				Fixable: false,
+			})
+		}
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,122 @@
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+// cronMinInterval returns the shortest interval between two runs of a POSIX cron
+// expression (as supported by GitHub Actions schedules).
+//
+// Only the minute and hour fields are used to compute frequency. The day-of-month,
+// month and day-of-week fields can only make a schedule run less often, so the result
+// is the worst case: a schedule that runs once per matching day reports 24h.
+func cronMinInterval(expr string) (time.Duration, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return 0, fmt.Errorf("expected 5 fields, got %d", len(fields))
+	}
+
+	minutes, err := parseCronField(fields[0], 0, 59)
+	if err != nil {
+		return 0, fmt.Errorf("invalid minute field: %w", err)
+	}
+	hours, err := parseCronField(fields[1], 0, 23)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hour field: %w", err)
+	}
+
+	times := make([]int, 0, len(hours)*len(minutes))
+	for _, h := range hours {
+		for _, m := range minutes {
+			times = append(times, h*60+m)
+		}
+	}
+	slices.Sort(times)
+
+	// Wrap-around gap from the last run of the day to the first run of the next day
+	minGap := times[0] + minutesPerDay - times[len(times)-1]
+	for i := 1; i < len(times); i++ {
+		minGap = min(minGap, times[i]-times[i-1])
+	}
+
+	return time.Duration(minGap) * time.Minute, nil
+}
+
+// parseCronField expands a cron field (e.g. "*/15", "1-5", "0,30") into its values
+func parseCronField(field string, lo, hi int) ([]int, error) {
+	seen := make(map[int]bool)
+
+	for part := range strings.SplitSeq(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+
+		start, end := lo, hi
+		switch {
+		case rangePart == "*":
+			// full range
+		case strings.Contains(rangePart, "-"):
+			from, to, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = parseCronValue(from, lo, hi); err != nil {
+				return nil, err
+			}
+			if end, err = parseCronValue(to, lo, hi); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range %q", rangePart)
+			}
+		default:
+			value, err := parseCronValue(rangePart, lo, hi)
+			if err != nil {
+				return nil, err
+			}
+			start = value
+			if !hasStep {
+				end = value
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			seen[v] = true
+		}
+	}
+
+	if len(seen) == 0 {
+		return nil, errors.New("no values")
+	}
+
+	values := make([]int, 0, len(seen))
+	for v := range seen {
+		values = append(values, v)
+	}
+	slices.Sort(values)
+	return values, nil
+}
+
+// parseCronValue parses a single numeric cron value within [lo, hi]
+func parseCronValue(s string, lo, hi int) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < lo || v > hi {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, lo, hi)
+	}
+	return v, nil
+}
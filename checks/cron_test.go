@@ -0,0 +1,56 @@
+package checks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCronMinInterval(t *testing.T) {
+	tests := []struct {
+		expr string
+		want time.Duration
+	}{
+		{"* * * * *", time.Minute},
+		{"*/5 * * * *", 5 * time.Minute},
+		{"0 * * * *", time.Hour},
+		{"0,30 * * * *", 30 * time.Minute},
+		{"0 */6 * * *", 6 * time.Hour},
+		{"0 0 * * *", 24 * time.Hour},
+		{"30 2 * * 1", 24 * time.Hour}, // Weekly schedules are bounded by the daily worst case
+		{"0 9-17 * * 1-5", time.Hour},
+		{"0 1,23 * * *", 2 * time.Hour}, // Wraps around midnight
+		{"15/20 * * * *", 20 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := cronMinInterval(tt.expr)
+			if err != nil {
+				t.Fatalf("cronMinInterval(%q) returned unexpected error: %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Errorf("cronMinInterval(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCronMinInterval_Invalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	}
+
+	for _, expr := range invalid {
+		t.Run(expr, func(t *testing.T) {
+			if _, err := cronMinInterval(expr); err == nil {
+				t.Errorf("cronMinInterval(%q) should have returned an error", expr)
+			}
+		})
+	}
+}
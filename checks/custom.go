@@ -0,0 +1,154 @@
+package checks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// CustomCheck runs an external command that implements an organization-specific policy.
+// The command runs in the working tree with the repository context in REPOLINT_OWNER,
+// REPOLINT_REPO and REPOLINT_DEFAULT_BRANCH, and reports issues as a JSON array of
+// {"message", "fixable"} objects on stdout.
+type CustomCheck struct {
+	client    *github.Client
+	config    *config.CustomCheckConfig
+	allowExec bool
+	verbose   bool
+}
+
+// customResult is one issue reported by a custom check command
+type customResult struct {
+	Message string `json:"message"`
+	Fixable bool   `json:"fixable"`
+}
+
+// NewCustomCheck creates a new custom check
+func NewCustomCheck(client *github.Client, cfg *config.CustomCheckConfig, verbose bool) *CustomCheck {
+	return &CustomCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// SetAllowExec controls whether the command is run; custom checks are not run by default
+func (c *CustomCheck) SetAllowExec(allowed bool) {
+	c.allowExec = allowed
+}
+
+// Type returns the check type
+func (c *CustomCheck) Type() CheckType {
+	return CheckTypeCustom
+}
+
+// Name returns the check name
+func (c *CustomCheck) Name() string {
+	return "custom(" + c.config.Name + ")"
+}
+
+// Run executes the custom check
+func (c *CustomCheck) Run(ctx context.Context) ([]Issue, error) {
+	if !c.allowExec {
+		return nil, fmt.Errorf("%w (use --allow-exec)", ErrCheckNotRun)
+	}
+
+	cmd, err := CustomCommand(ctx, c.client, c.config, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if c.verbose {
+		fmt.Fprintf(os.Stderr, "Running custom check %s: %s\n", c.config.Name, strings.Join(c.config.Exec, " "))
+	}
+
+	runErr := cmd.Run()
+	var exitErr *exec.ExitError
+	if runErr != nil && !errors.As(runErr, &exitErr) {
+		return nil, fmt.Errorf("failed to run custom check %s: %w", c.config.Name, runErr)
+	}
+
+	results, err := parseCustomOutput(stdout.Bytes())
+	if err != nil {
+		return []Issue{c.issue(fmt.Sprintf("Command output is not a JSON array of issues: %v", err), false)}, nil
+	}
+
+	issues := make([]Issue, 0, len(results))
+	for _, result := range results {
+		issues = append(issues, c.issue(result.Message, result.Fixable))
+	}
+
+	// A failing command must report at least one issue
+	if exitErr != nil && len(issues) == 0 {
+		message := fmt.Sprintf("Command exited with status %d", exitErr.ExitCode())
+		if line, _, _ := strings.Cut(strings.TrimSpace(stderr.String()), "\n"); line != "" {
+			message += ": " + line
+		}
+		issues = append(issues, c.issue(message, false))
+	}
+
+	return issues, nil
+}
+
+func (c *CustomCheck) issue(message string, fixable bool) Issue {
+	return Issue{
+		Type:    c.Type(),
+		Name:    c.Name(),
+		Message: message,
+		Fixable: fixable,
+		Data:    map[string]string{DataKeyCustomCheck: c.config.Name},
+	}
+}
+
+// CustomCommand builds the command for a custom check. With fix set, REPOLINT_FIX=1 is
+// added to the environment so that the command fixes the issues it reports.
+func CustomCommand(ctx context.Context, client *github.Client, cfg *config.CustomCheckConfig, fix bool) (*exec.Cmd, error) {
+	repo, err := client.GetRepository()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch repository: %w", err)
+	}
+
+	// The command inherits the working directory, which is the repository checkout
+	cmd := exec.CommandContext(ctx, cfg.Exec[0], cfg.Exec[1:]...)
+	cmd.Env = append(os.Environ(),
+		"REPOLINT_OWNER="+client.Owner(),
+		"REPOLINT_REPO="+client.Repo(),
+		"REPOLINT_DEFAULT_BRANCH="+repo.DefaultBranch,
+	)
+	if fix {
+		cmd.Env = append(cmd.Env, "REPOLINT_FIX=1")
+	}
+	return cmd, nil
+}
+
+// parseCustomOutput decodes the issues written to stdout by a custom check command.
+// Empty output means no issues.
+func parseCustomOutput(stdout []byte) ([]customResult, error) {
+	stdout = bytes.TrimSpace(stdout)
+	if len(stdout) == 0 {
+		return nil, nil
+	}
+
+	var results []customResult
+	if err := json.Unmarshal(stdout, &results); err != nil {
+		return nil, err
+	}
+	for i, result := range results {
+		if result.Message == "" {
+			return nil, fmt.Errorf("issue %d has no message", i)
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,36 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseCustomOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		stdout  string
+		want    []customResult
+		wantErr bool
+	}{
+		{"empty", "", nil, false},
+		{"whitespace", " \n", nil, false},
+		{"empty array", "[]", []customResult{}, false},
+		{"issues", `[{"message": "missing header", "fixable": true}, {"message": "bad name"}]`,
+			[]customResult{{Message: "missing header", Fixable: true}, {Message: "bad name"}}, false},
+		{"missing message", `[{"fixable": true}]`, nil, true},
+		{"not json", "all good", nil, true},
+		{"object", `{"message": "missing header"}`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCustomOutput([]byte(tt.stdout))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCustomOutput(%q) error = %v, wantErr %v", tt.stdout, err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("parseCustomOutput(%q) = %v, want %v", tt.stdout, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// dependabotFilePath is the location GitHub reads Dependabot version updates configuration from
+const dependabotFilePath = ".github/dependabot.yml"
+
+// DependabotCheck validates the update blocks of .github/dependabot.yml
+type DependabotCheck struct {
+	client  *github.Client
+	config  *config.DependabotConfig
+	verbose bool
+}
+
+// NewDependabotCheck creates a new dependabot check
+func NewDependabotCheck(client *github.Client, cfg *config.DependabotConfig, verbose bool) *DependabotCheck {
+	return &DependabotCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *DependabotCheck) Type() CheckType {
+	return CheckTypeDependabot
+}
+
+// Name returns the check name
+func (c *DependabotCheck) Name() string {
+	return "dependabot"
+}
+
+// Run executes the dependabot check
+func (c *DependabotCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil {
+		return nil, nil
+	}
+
+	var issues []Issue
+
+	content, err := c.client.GetLocalFileContent(dependabotFilePath)
+	if err != nil {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("File '%s' does not exist", dependabotFilePath),
+			Fixable: false,
+		})
+		return issues, nil //nolint:nilerr // Intentional: missing file is a reportable issue, not an error
+	}
+
+	var dependabot github.DependabotConfig
+	if err := yaml.Unmarshal(content, &dependabot); err != nil {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			File:    dependabotFilePath,
+			Message: fmt.Sprintf("File '%s' is not valid YAML: %s", dependabotFilePath, err),
+			Fixable: false,
+		})
+		return issues, nil
+	}
+
+	defaultBranch := ""
+	if c.config.TargetBranch != nil {
+		repo, err := c.client.GetRepository()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch repository: %w", err)
+		}
+		defaultBranch = repo.DefaultBranch
+	}
+
+	for _, update := range dependabot.Updates {
+		issues = append(issues, c.checkOpenPullRequestsLimit(update)...)
+		if c.config.TargetBranch != nil {
+			issues = append(issues, c.checkTargetBranch(update, defaultBranch)...)
+		}
+		issues = append(issues, c.checkScheduleInterval(update)...)
+	}
+
+	return issues, nil
+}
+
+// checkTargetBranch verifies an update block for a configured ecosystem opens pull requests
+// against target_branch.branch, or the default branch when that is not set. An unset
+// target-branch means the default branch.
+func (c *DependabotCheck) checkTargetBranch(update github.DependabotUpdate, defaultBranch string) []Issue {
+	ecosystems := c.config.TargetBranch.Ecosystems
+	if len(ecosystems) > 0 && !slices.Contains(ecosystems, update.PackageEcosystem) {
+		return nil
+	}
+
+	expected := c.config.TargetBranch.Branch
+	if expected == "" {
+		expected = defaultBranch
+	}
+	actual := update.TargetBranch
+	if actual == "" {
+		actual = defaultBranch
+	}
+	if actual == expected {
+		return nil
+	}
+
+	return []Issue{{
+		Type: c.Type(),
+		Name: c.Name(),
+		File: dependabotFilePath,
+		Message: fmt.Sprintf("Dependabot update for %s in '%s' targets '%s' but should target '%s'",
+			update.PackageEcosystem, update.Directory, actual, expected),
+		Fixable: false,
+	}}
+}
+
+// checkScheduleInterval verifies an update block uses the schedule.interval configured for its ecosystem
+func (c *DependabotCheck) checkScheduleInterval(update github.DependabotUpdate) []Issue {
+	expected, ok := c.config.ScheduleIntervals[update.PackageEcosystem]
+	if !ok || update.Schedule.Interval == expected {
+		return nil
+	}
+
+	if update.Schedule.Interval == "" {
+		return []Issue{{
+			Type: c.Type(),
+			Name: c.Name(),
+			File: dependabotFilePath,
+			Message: fmt.Sprintf("Dependabot update for %s in '%s' does not set schedule.interval but should run %s",
+				update.PackageEcosystem, update.Directory, expected),
+			Fixable: false,
+		}}
+	}
+
+	return []Issue{{
+		Type: c.Type(),
+		Name: c.Name(),
+		File: dependabotFilePath,
+		Message: fmt.Sprintf("Dependabot update for %s in '%s' runs %s but should run %s",
+			update.PackageEcosystem, update.Directory, update.Schedule.Interval, expected),
+		Fixable: false,
+	}}
+}
+
+// checkOpenPullRequestsLimit verifies an update block sets open-pull-requests-limit within the configured max
+func (c *DependabotCheck) checkOpenPullRequestsLimit(update github.DependabotUpdate) []Issue {
+	target := fmt.Sprintf("%s in '%s'", update.PackageEcosystem, update.Directory)
+
+	if update.OpenPullRequestsLimit == nil {
+		if c.config.RequireOpenPullRequestsLimit != nil && *c.config.RequireOpenPullRequestsLimit {
+			return []Issue{{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    dependabotFilePath,
+				Message: fmt.Sprintf("Dependabot update for %s does not set open-pull-requests-limit", target),
+				Fixable: false,
+			}}
+		}
+		return nil
+	}
+
+	if c.config.MaxOpenPullRequestsLimit != nil && *update.OpenPullRequestsLimit > *c.config.MaxOpenPullRequestsLimit {
+		return []Issue{{
+			Type: c.Type(),
+			Name: c.Name(),
+			File: dependabotFilePath,
+			Message: fmt.Sprintf("Dependabot update for %s has open-pull-requests-limit %d (max: %d)",
+				target, *update.OpenPullRequestsLimit, *c.config.MaxOpenPullRequestsLimit),
+			Fixable: false,
+		}}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestCheckScheduleInterval(t *testing.T) {
+	c := &DependabotCheck{config: &config.DependabotConfig{
+		ScheduleIntervals: map[string]string{"gomod": "weekly"},
+	}}
+	update := func(ecosystem, interval string) github.DependabotUpdate {
+		return github.DependabotUpdate{
+			PackageEcosystem: ecosystem,
+			Directory:        "/",
+			Schedule:         github.DependabotSchedule{Interval: interval},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		update github.DependabotUpdate
+		want   string
+	}{
+		{"matching interval", update("gomod", "weekly"), ""},
+		{"different interval", update("gomod", "daily"), "Dependabot update for gomod in '/' runs daily but should run weekly"},
+		{"ecosystem without interval", update("npm", "daily"), ""},
+		{"missing interval", update("gomod", ""), "Dependabot update for gomod in '/' does not set schedule.interval but should run weekly"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := c.checkScheduleInterval(tt.update)
+			switch {
+			case tt.want == "" && len(issues) != 0:
+				t.Errorf("checkScheduleInterval() = %+v, want no issues", issues)
+			case tt.want != "" && (len(issues) != 1 || issues[0].Message != tt.want):
+				t.Errorf("checkScheduleInterval() = %+v, want one issue %q", issues, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package checks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// checkDiscussionCategories verifies that every category in discussion_categories exists.
+// Categories cannot be managed through the API, so issues are not fixable.
+func (c *SettingsCheck) checkDiscussionCategories(discussionsEnabled bool) ([]Issue, error) {
+	if !discussionsEnabled {
+		return []Issue{{
+			Type: c.Type(),
+			Name: c.Name(),
+			Message: fmt.Sprintf("Discussions is disabled but discussion categories are required: %s",
+				strings.Join(c.config.DiscussionCategories, ", ")),
+			Fixable: false,
+		}}, nil
+	}
+
+	categories, err := c.client.GetDiscussionCategories()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch discussion categories: %w", err)
+	}
+
+	var issues []Issue
+	for _, required := range c.config.DiscussionCategories {
+		if !hasDiscussionCategory(categories, required) {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Discussion category '%s' is missing", required),
+				Fixable: false,
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+// hasDiscussionCategory reports whether a category with the given name exists, ignoring case
+func hasDiscussionCategory(categories []github.DiscussionCategory, name string) bool {
+	for _, category := range categories {
+		if strings.EqualFold(category.Name, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package checks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// checkEnvironments reports jobs that deploy to an environment without protection rules
+// (required reviewers, a wait timer or a deployment branch policy). An environment that
+// does not exist yet is created unprotected on the first deployment, so it is reported too.
+// Environment names given as expressions cannot be resolved and are skipped.
+func (c *ActionsCheck) checkEnvironments(wfPath string, wf *github.Workflow) ([]Issue, error) {
+	var issues []Issue
+
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		job := wf.Jobs[jobName]
+		name := job.EnvironmentName()
+		if name == "" || strings.Contains(name, "${{") {
+			continue
+		}
+
+		env, err := c.client.GetEnvironment(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch environment %s: %w", name, err)
+		}
+
+		var message string
+		switch {
+		case env == nil:
+			message = fmt.Sprintf("Job '%s' in '%s' deploys to environment '%s', which does not exist and would be created without protection rules", jobName, wfPath, name)
+		case len(env.ProtectionRules) == 0:
+			message = fmt.Sprintf("Job '%s' in '%s' deploys to environment '%s', which has no protection rules", jobName, wfPath, name)
+		default:
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			File:    wfPath,
+			Message: message,
+			Fixable: false,
+		})
+	}
+
+	return issues, nil
+}
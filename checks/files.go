@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
+	"regexp"
+	"slices"
 
 	"github.com/sethrylan/gh-repolint/config"
 	"github.com/sethrylan/gh-repolint/github"
@@ -41,14 +44,28 @@ func (c *FilesCheck) Run(ctx context.Context) ([]Issue, error) {
 		return nil, nil
 	}
 
-	if c.config.Reference == "" {
+	if c.config.Reference == "" && len(c.config.LanguageReferences) == 0 {
 		return nil, fmt.Errorf("file '%s' missing required reference field", c.config.Name)
 	}
 
 	var issues []Issue
 
+	reference, err := c.selectReference()
+	if err != nil {
+		return nil, err
+	}
+
+	ignore, err := compileIgnorePatterns(c.config.IgnorePatterns)
+	if err != nil {
+		return nil, err
+	}
+	if reference == "" {
+		// No template for this repository's language and no fallback
+		return nil, nil
+	}
+
 	// Fetch the expected file content from reference
-	expectedContent, err := github.ResolveReferenceFile(c.config.Reference, c.client)
+	expectedContent, err := github.ResolveReferenceFile(reference, c.client)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch reference file: %w", err)
 	}
@@ -70,25 +87,137 @@ func (c *FilesCheck) Run(ctx context.Context) ([]Issue, error) {
 			Fixable: true,
 			Data: map[string]string{
 				DataKeyFileName:  c.config.Name,
-				DataKeyReference: c.config.Reference,
+				DataKeyReference: reference,
 			},
 		})
 		return issues, nil //nolint:nilerr // Intentional: missing file is a reportable issue, not an error
 	}
 
+	actualContent = stripIgnoredLines(actualContent, ignore)
+	hydratedContent = stripIgnoredLines(hydratedContent, ignore)
+	matchesPinned := contentEqual(actualContent, hydratedContent)
+
+	// With drift detection, classify against the reference's default branch as well
+	if remote := c.driftReference(reference); remote != nil {
+		headContent, err := c.client.GetRemoteFileContentAtRef(remote.Owner, remote.Repo, remote.Path, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reference file at default branch: %w", err)
+		}
+		hydratedHead, err := c.client.HydrateTemplate(headContent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hydrate reference template: %w", err)
+		}
+		matchesHead := contentEqual(actualContent, stripIgnoredLines(hydratedHead, ignore))
+
+		switch {
+		case matchesPinned && !matchesHead:
+			issues = append(issues, Issue{
+				Type:     c.Type(),
+				Name:     c.Name(),
+				File:     c.config.Name,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("File '%s' matches pinned reference '%s' but the reference has since changed on its default branch (update the pin)", c.config.Name, reference),
+				Fixable:  false,
+			})
+			return issues, nil
+		case !matchesPinned && matchesHead:
+			issues = append(issues, Issue{
+				Type:     c.Type(),
+				Name:     c.Name(),
+				File:     c.config.Name,
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("File '%s' matches the reference's default branch but not pinned ref '%s' (the pin is stale)", c.config.Name, remote.Ref),
+				Fixable:  false,
+			})
+			return issues, nil
+		}
+	}
+
 	// Compare the contents, ignoring trailing whitespace
-	if !bytes.Equal(bytes.TrimSpace(actualContent), bytes.TrimSpace(hydratedContent)) {
+	if !matchesPinned {
 		issues = append(issues, Issue{
 			Type:    c.Type(),
 			Name:    c.Name(),
-			Message: fmt.Sprintf("File '%s' does not match reference '%s'", c.config.Name, c.config.Reference),
+			File:    c.config.Name,
+			Message: fmt.Sprintf("File '%s' does not match reference '%s'", c.config.Name, reference),
 			Fixable: true,
 			Data: map[string]string{
 				DataKeyFileName:  c.config.Name,
-				DataKeyReference: c.config.Reference,
+				DataKeyReference: reference,
 			},
 		})
 	}
 
 	return issues, nil
 }
+
+// selectReference returns the reference for the repository's primary language when
+// language_references is configured, falling back to reference
+func (c *FilesCheck) selectReference() (string, error) {
+	if len(c.config.LanguageReferences) == 0 {
+		return c.config.Reference, nil
+	}
+
+	language, err := c.client.GetPrimaryLanguage()
+	if err != nil {
+		return "", fmt.Errorf("failed to detect primary language: %w", err)
+	}
+	if reference, ok := c.config.LanguageReferences[language]; ok {
+		return reference, nil
+	}
+	return c.config.Reference, nil
+}
+
+// driftReference returns the pinned remote reference to classify drift against,
+// or nil if drift detection is disabled or the reference is not a pinned remote file
+func (c *FilesCheck) driftReference(reference string) *github.RemoteReference {
+	if c.config.DetectDrift == nil || !*c.config.DetectDrift {
+		return nil
+	}
+	// Local reference files take precedence in ResolveReferenceFile
+	if !github.IsURLReference(reference) {
+		if _, err := os.Stat(reference); err == nil {
+			return nil
+		}
+	}
+	remote, err := github.ParseRemoteReference(reference)
+	if err != nil || remote.Ref == "" {
+		return nil
+	}
+	return remote
+}
+
+// compileIgnorePatterns compiles the ignore_patterns of a file config
+func compileIgnorePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	ignore := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignore_patterns entry %q: %w", pattern, err)
+		}
+		ignore = append(ignore, re)
+	}
+	return ignore, nil
+}
+
+// stripIgnoredLines removes the lines of content that match any of the patterns
+func stripIgnoredLines(content []byte, ignore []*regexp.Regexp) []byte {
+	if len(ignore) == 0 {
+		return content
+	}
+
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	kept := lines[:0]
+	for _, line := range lines {
+		trimmed := bytes.TrimRight(line, "\r\n")
+		if !slices.ContainsFunc(ignore, func(re *regexp.Regexp) bool { return re.Match(trimmed) }) {
+			kept = append(kept, line)
+		}
+	}
+	return bytes.Join(kept, nil)
+}
+
+// contentEqual compares file contents, ignoring surrounding whitespace
+func contentEqual(a, b []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(a), bytes.TrimSpace(b))
+}
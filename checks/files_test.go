@@ -0,0 +1,29 @@
+package checks
+
+import "testing"
+
+func TestStripIgnoredLines(t *testing.T) {
+	ignore, err := compileIgnorePatterns([]string{`^# version: `, `generated`})
+	if err != nil {
+		t.Fatalf("compileIgnorePatterns() returned unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"no matches", "name: ci\non: push\n", "name: ci\non: push\n"},
+		{"header stamp", "# version: 1.2.3\nname: ci\n", "name: ci\n"},
+		{"crlf line endings", "# version: 1.2.3\r\nname: ci\r\n", "name: ci\r\n"},
+		{"last line without newline", "name: ci\n# generated by tool", "name: ci\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(stripIgnoredLines([]byte(tt.content), ignore)); got != tt.want {
+				t.Errorf("stripIgnoredLines(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// fundingFilePath is the location GitHub reads sponsorship configuration from
+const fundingFilePath = ".github/FUNDING.yml"
+
+// FundingCheck validates that public repositories declare the required sponsorship entries
+type FundingCheck struct {
+	client  *github.Client
+	config  *config.FundingConfig
+	verbose bool
+}
+
+// NewFundingCheck creates a new funding check
+func NewFundingCheck(client *github.Client, cfg *config.FundingConfig, verbose bool) *FundingCheck {
+	return &FundingCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *FundingCheck) Type() CheckType {
+	return CheckTypeFunding
+}
+
+// Name returns the check name
+func (c *FundingCheck) Name() string {
+	return "funding"
+}
+
+// Run executes the funding check
+func (c *FundingCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil {
+		return nil, nil
+	}
+
+	repo, err := c.client.GetRepository()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch repository: %w", err)
+	}
+
+	// Sponsorship is only required for public repositories
+	if repo.Visibility != "public" {
+		return nil, nil
+	}
+
+	var issues []Issue
+
+	content, err := c.client.GetLocalFileContent(fundingFilePath)
+	if err != nil {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("File '%s' does not exist", fundingFilePath),
+			Fixable: false,
+		})
+		return issues, nil //nolint:nilerr // Intentional: missing file is a reportable issue, not an error
+	}
+
+	var funding map[string]any
+	if err := yaml.Unmarshal(content, &funding); err != nil {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			File:    fundingFilePath,
+			Message: fmt.Sprintf("File '%s' is not valid YAML: %s", fundingFilePath, err),
+			Fixable: false,
+		})
+		return issues, nil
+	}
+
+	issues = append(issues, c.checkEntries("github", c.config.GitHub, stringOrList(funding["github"]))...)
+	issues = append(issues, c.checkEntries("custom", c.config.Custom, stringOrList(funding["custom"]))...)
+
+	return issues, nil
+}
+
+// checkEntries reports each expected entry missing from the actual entries for a funding platform
+func (c *FundingCheck) checkEntries(platform string, expected, actual []string) []Issue {
+	var issues []Issue
+	for _, entry := range expected {
+		if !slices.Contains(actual, entry) {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    fundingFilePath,
+				Message: fmt.Sprintf("File '%s' does not include %s entry '%s'", fundingFilePath, platform, entry),
+				Fixable: false,
+			})
+		}
+	}
+	return issues
+}
+
+// stringOrList normalizes a YAML value that may be a single string or a list of strings
+func stringOrList(v any) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case []any:
+		var result []string
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
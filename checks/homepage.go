@@ -0,0 +1,105 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/gobwas/glob"
+)
+
+// linkCheckTimeout bounds each request made with --check-links
+const linkCheckTimeout = 10 * time.Second
+
+// checkHomepage validates the repository homepage's host against homepage_allowed_hosts
+// and, with --check-links, that the homepage responds
+func (c *SettingsCheck) checkHomepage(homepage string) ([]Issue, error) {
+	if homepage == "" {
+		return []Issue{{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: "Homepage is not set",
+			Fixable: false,
+		}}, nil
+	}
+
+	u, err := url.Parse(homepage)
+	if err != nil || u.Hostname() == "" {
+		return []Issue{{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Homepage '%s' is not a valid URL", homepage),
+			Fixable: false,
+		}}, nil
+	}
+
+	globs := make([]glob.Glob, 0, len(c.config.HomepageAllowedHosts))
+	for _, pattern := range c.config.HomepageAllowedHosts {
+		g, err := glob.Compile(pattern, '.')
+		if err != nil {
+			return nil, fmt.Errorf("invalid homepage_allowed_hosts pattern: %w", err)
+		}
+		globs = append(globs, g)
+	}
+
+	host := u.Hostname()
+	if !slices.ContainsFunc(globs, func(g glob.Glob) bool { return g.Match(host) }) {
+		return []Issue{{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Homepage host '%s' is not one of the allowed hosts: %v", host, c.config.HomepageAllowedHosts),
+			Fixable: false,
+		}}, nil
+	}
+
+	if c.checkLinks {
+		if err := checkLink(homepage); err != nil {
+			return []Issue{{
+				Type:     c.Type(),
+				Name:     c.Name(),
+				Severity: SeverityWarning,
+				Message:  fmt.Sprintf("Homepage '%s' did not resolve: %v", homepage, err),
+				Fixable:  false,
+			}}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// checkLink confirms a URL responds without an error status. It sends a HEAD request,
+// falling back to GET for servers that do not allow HEAD.
+func checkLink(link string) error {
+	client := &http.Client{Timeout: linkCheckTimeout}
+
+	status, err := requestStatus(client, http.MethodHead, link)
+	if err == nil && status == http.StatusMethodNotAllowed {
+		status, err = requestStatus(client, http.MethodGet, link)
+	}
+	if err != nil {
+		return err
+	}
+	if status >= http.StatusBadRequest {
+		return fmt.Errorf("HTTP %d", status)
+	}
+	return nil
+}
+
+func requestStatus(client *http.Client, method, link string) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), linkCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	_ = resp.Body.Close()
+	return resp.StatusCode, nil
+}
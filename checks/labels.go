@@ -0,0 +1,94 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// LabelsCheck validates that required issue/PR labels exist
+type LabelsCheck struct {
+	client  *github.Client
+	config  []config.LabelConfig
+	verbose bool
+}
+
+// NewLabelsCheck creates a new labels check
+func NewLabelsCheck(client *github.Client, cfgs []config.LabelConfig, verbose bool) *LabelsCheck {
+	return &LabelsCheck{
+		client:  client,
+		config:  cfgs,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *LabelsCheck) Type() CheckType {
+	return CheckTypeLabels
+}
+
+// Name returns the check name
+func (c *LabelsCheck) Name() string {
+	return "labels"
+}
+
+// Run executes the labels check
+func (c *LabelsCheck) Run(ctx context.Context) ([]Issue, error) {
+	if len(c.config) == 0 {
+		return nil, nil
+	}
+
+	labels, err := c.client.ListLabels()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch labels: %w", err)
+	}
+
+	// Label names are case-insensitive on GitHub
+	existing := make(map[string]github.Label)
+	for _, l := range labels {
+		existing[strings.ToLower(l.Name)] = l
+	}
+
+	var issues []Issue
+
+	for _, expected := range c.config {
+		data := map[string]string{DataKeyLabel: expected.Name}
+
+		actual, ok := existing[strings.ToLower(expected.Name)]
+		if !ok {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Label '%s' does not exist", expected.Name),
+				Fixable: true,
+				Data:    data,
+			})
+			continue
+		}
+
+		if expected.Color != "" && !strings.EqualFold(actual.Color, expected.Color) {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Label '%s' has color '%s' but should be '%s'", expected.Name, actual.Color, expected.Color),
+				Fixable: true,
+				Data:    data,
+			})
+		}
+
+		if expected.Description != "" && actual.Description != expected.Description {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Label '%s' has description '%s' but should be '%s'", expected.Name, actual.Description, expected.Description),
+				Fixable: true,
+				Data:    data,
+			})
+		}
+	}
+
+	return issues, nil
+}
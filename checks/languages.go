@@ -0,0 +1,113 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// LanguagesCheck validates the repository's primary language and that no forbidden language is used
+type LanguagesCheck struct {
+	client  *github.Client
+	config  *config.LanguagesConfig
+	verbose bool
+}
+
+// NewLanguagesCheck creates a new languages check
+func NewLanguagesCheck(client *github.Client, cfg *config.LanguagesConfig, verbose bool) *LanguagesCheck {
+	return &LanguagesCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *LanguagesCheck) Type() CheckType {
+	return CheckTypeLanguages
+}
+
+// Name returns the check name
+func (c *LanguagesCheck) Name() string {
+	return "languages"
+}
+
+// Run executes the languages check
+func (c *LanguagesCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil || (c.config.Primary == "" && len(c.config.Forbidden) == 0) {
+		return nil, nil
+	}
+
+	languages, err := c.client.GetLanguages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch languages: %w", err)
+	}
+
+	var issues []Issue
+
+	if c.config.Primary != "" {
+		primary, err := c.client.GetPrimaryLanguage()
+		if err != nil {
+			return nil, fmt.Errorf("failed to detect primary language: %w", err)
+		}
+		if !strings.EqualFold(primary, c.config.Primary) {
+			actual := primary
+			if actual == "" {
+				actual = "none detected"
+			}
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Primary language is '%s', expected '%s'", actual, c.config.Primary),
+			})
+		}
+	}
+
+	threshold := 0
+	if c.config.ForbiddenThresholdPercent != nil {
+		threshold = *c.config.ForbiddenThresholdPercent
+	}
+	for _, found := range forbiddenLanguages(languages, c.config.Forbidden, threshold) {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Forbidden language '%s' makes up %.1f%% of the code", found.name, found.percent),
+		})
+	}
+
+	return issues, nil
+}
+
+// languageShare is a language and its percentage of the repository's bytes of code
+type languageShare struct {
+	name    string
+	percent float64
+}
+
+// forbiddenLanguages returns the forbidden languages whose share of the code exceeds
+// thresholdPercent, sorted by name. Language names are matched case-insensitively.
+func forbiddenLanguages(languages map[string]int, forbidden []string, thresholdPercent int) []languageShare {
+	total := 0
+	for _, bytes := range languages {
+		total += bytes
+	}
+	if total == 0 {
+		return nil
+	}
+
+	var found []languageShare
+	for _, name := range sortedKeys(languages) {
+		if !slices.ContainsFunc(forbidden, func(f string) bool { return strings.EqualFold(f, name) }) {
+			continue
+		}
+		percent := float64(languages[name]) * 100 / float64(total)
+		if percent > float64(thresholdPercent) {
+			found = append(found, languageShare{name: name, percent: percent})
+		}
+	}
+	return found
+}
@@ -0,0 +1,40 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestForbiddenLanguages(t *testing.T) {
+	languages := map[string]int{"Go": 9000, "Shell": 900, "Perl": 100}
+
+	tests := []struct {
+		name      string
+		forbidden []string
+		threshold int
+		want      []string
+	}{
+		{"none forbidden", nil, 0, nil},
+		{"any amount", []string{"Perl", "Shell"}, 0, []string{"Perl", "Shell"}},
+		{"above threshold", []string{"Perl", "Shell"}, 5, []string{"Shell"}},
+		{"at threshold is ignored", []string{"Perl"}, 1, nil},
+		{"case insensitive", []string{"perl"}, 0, []string{"Perl"}},
+		{"absent language", []string{"Ruby"}, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, found := range forbiddenLanguages(languages, tt.forbidden, tt.threshold) {
+				got = append(got, found.name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("forbiddenLanguages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := forbiddenLanguages(map[string]int{}, []string{"Go"}, 0); got != nil {
+		t.Errorf("forbiddenLanguages() with no code = %v, want nil", got)
+	}
+}
@@ -0,0 +1,105 @@
+package checks
+
+import (
+	"fmt"
+
+	"github.com/sethrylan/gh-repolint/config"
+)
+
+// checkMergeQueue compares the parameters of the merge queue rule that applies to the
+// default branch against merge_queue. Repositories without a merge queue are not flagged.
+// The rule may come from an organization ruleset, so issues are not fixable.
+func (c *SettingsCheck) checkMergeQueue(defaultBranch string) ([]Issue, error) {
+	rules, err := c.client.GetBranchRules(defaultBranch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rules for branch %s: %w", defaultBranch, err)
+	}
+
+	var params map[string]any
+	found := false
+	for _, rule := range rules {
+		if rule.Type == "merge_queue" {
+			params = rule.Parameters
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	var issues []Issue
+	for _, d := range mergeQueueDifferences(c.config.MergeQueue, params) {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Merge queue %s is %s but should be %s", d.name, d.actual, d.expected),
+			Fixable: false,
+		})
+	}
+	return issues, nil
+}
+
+// mergeQueueDifference describes a merge queue parameter that does not match the config
+type mergeQueueDifference struct {
+	name     string
+	actual   string
+	expected string
+}
+
+// mergeQueueDifferences returns the configured merge queue parameters whose value differs
+// from the merge_queue rule parameters
+func mergeQueueDifferences(cfg *config.MergeQueueConfig, params map[string]any) []mergeQueueDifference {
+	var diffs []mergeQueueDifference
+
+	stringParams := []struct {
+		name     string
+		expected string
+	}{
+		{"merge_method", cfg.MergeMethod},
+		{"grouping_strategy", cfg.GroupingStrategy},
+	}
+	for _, s := range stringParams {
+		if s.expected == "" {
+			continue
+		}
+		actual, _ := params[s.name].(string)
+		if actual != s.expected {
+			diffs = append(diffs, mergeQueueDifference{s.name, formatParam(params[s.name]), s.expected})
+		}
+	}
+
+	ints := []struct {
+		name     string
+		expected *int
+	}{
+		{"max_entries_to_build", cfg.MaxEntriesToBuild},
+		{"max_entries_to_merge", cfg.MaxEntriesToMerge},
+		{"min_entries_to_merge", cfg.MinEntriesToMerge},
+		{"min_entries_to_merge_wait_minutes", cfg.MinEntriesToMergeWaitMinutes},
+		{"check_response_timeout_minutes", cfg.CheckResponseTimeoutMinutes},
+	}
+	for _, i := range ints {
+		if i.expected == nil {
+			continue
+		}
+		actual, ok := params[i.name].(float64)
+		if !ok || int(actual) != *i.expected {
+			diffs = append(diffs, mergeQueueDifference{i.name, formatParam(params[i.name]), fmt.Sprintf("%d", *i.expected)})
+		}
+	}
+
+	return diffs
+}
+
+// formatParam formats a rule parameter value decoded from JSON for display
+func formatParam(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "unset"
+	case float64:
+		return fmt.Sprintf("%d", int(v))
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
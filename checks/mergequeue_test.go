@@ -0,0 +1,50 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/config"
+)
+
+func TestMergeQueueDifferences(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+	params := map[string]any{
+		"merge_method":                      "SQUASH",
+		"grouping_strategy":                 "ALLGREEN",
+		"max_entries_to_build":              float64(5),
+		"max_entries_to_merge":              float64(5),
+		"min_entries_to_merge":              float64(1),
+		"min_entries_to_merge_wait_minutes": float64(5),
+		"check_response_timeout_minutes":    float64(60),
+	}
+
+	tests := []struct {
+		name string
+		cfg  config.MergeQueueConfig
+		want []string
+	}{
+		{"empty config", config.MergeQueueConfig{}, nil},
+		{"matching", config.MergeQueueConfig{MergeMethod: "SQUASH", MaxEntriesToBuild: intPtr(5), MinEntriesToMergeWaitMinutes: intPtr(5)}, nil},
+		{"different method", config.MergeQueueConfig{MergeMethod: "MERGE"}, []string{"merge_method"}},
+		{"different counts", config.MergeQueueConfig{MaxEntriesToBuild: intPtr(10), CheckResponseTimeoutMinutes: intPtr(30)}, []string{"max_entries_to_build", "check_response_timeout_minutes"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffs := mergeQueueDifferences(&tt.cfg, params)
+			if len(diffs) != len(tt.want) {
+				t.Fatalf("mergeQueueDifferences() = %+v, want %v", diffs, tt.want)
+			}
+			for i, d := range diffs {
+				if d.name != tt.want[i] {
+					t.Errorf("difference %d = %s, want %s", i, d.name, tt.want[i])
+				}
+			}
+		})
+	}
+
+	diffs := mergeQueueDifferences(&config.MergeQueueConfig{MaxEntriesToMerge: intPtr(3)}, map[string]any{})
+	if len(diffs) != 1 || diffs[0].actual != "unset" {
+		t.Errorf("missing parameter should be reported as unset, got %+v", diffs)
+	}
+}
@@ -0,0 +1,115 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// OrganizationCheck validates organization-level settings
+// It runs once per owner in org scans rather than once per repository
+type OrganizationCheck struct {
+	client  *github.Client
+	config  *config.OrganizationConfig
+	verbose bool
+}
+
+// NewOrganizationCheck creates a new organization check
+func NewOrganizationCheck(client *github.Client, cfg *config.OrganizationConfig, verbose bool) *OrganizationCheck {
+	return &OrganizationCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *OrganizationCheck) Type() CheckType {
+	return CheckTypeOrganization
+}
+
+// Name returns the check name
+func (c *OrganizationCheck) Name() string {
+	return "organization"
+}
+
+// Run executes the organization check
+func (c *OrganizationCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil {
+		return nil, nil
+	}
+
+	org, err := c.client.GetOrganization()
+	if err != nil {
+		// User accounts have no organization settings
+		if github.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch organization: %w", err)
+	}
+
+	var issues []Issue
+
+	// GitHub only returns the member settings to organization owners, so an empty
+	// default_repository_permission means they are unknown rather than wrong
+	membersVisible := org.DefaultRepositoryPermission != ""
+	if !membersVisible && (c.config.DefaultRepositoryPermission != "" || c.config.MembersCanCreateRepositories != nil) {
+		issues = append(issues, Issue{
+			Type:     c.Type(),
+			Name:     c.Name(),
+			Severity: SeverityWarning,
+			Message:  "Default repository permission and repository creation settings are only visible to organization owners, so they were not checked",
+			Fixable:  false,
+		})
+	}
+
+	if membersVisible && c.config.DefaultRepositoryPermission != "" && org.DefaultRepositoryPermission != c.config.DefaultRepositoryPermission {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Default repository permission is '%s' but should be '%s'", org.DefaultRepositoryPermission, c.config.DefaultRepositoryPermission),
+			Fixable: true,
+			Data:    map[string]string{DataKeySetting: "default_repository_permission"},
+		})
+	}
+
+	if membersVisible && c.config.MembersCanCreateRepositories != nil && org.MembersCanCreateRepositories != *c.config.MembersCanCreateRepositories {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Members can create repositories is %s but should be %s", boolToEnabled(org.MembersCanCreateRepositories), boolToEnabled(*c.config.MembersCanCreateRepositories)),
+			Fixable: true,
+			Data:    map[string]string{DataKeySetting: "members_can_create_repositories"},
+		})
+	}
+
+	// A permissive default grants write access to every workflow that does not set permissions
+	if c.config.DefaultWorkflowPermissions != "" {
+		perms, err := c.client.GetOrganizationWorkflowPermissions()
+		switch {
+		case github.IsForbidden(err) || github.IsNotFound(err):
+			// Only organization owners can read the workflow permissions
+			issues = append(issues, Issue{
+				Type:     c.Type(),
+				Name:     c.Name(),
+				Severity: SeverityWarning,
+				Message:  "Default workflow permissions are only visible to organization owners, so they were not checked",
+				Fixable:  false,
+			})
+		case err != nil:
+			return nil, fmt.Errorf("failed to fetch organization workflow permissions: %w", err)
+		case perms.DefaultWorkflowPermissions != c.config.DefaultWorkflowPermissions:
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Default workflow permissions are '%s' but should be '%s'", perms.DefaultWorkflowPermissions, c.config.DefaultWorkflowPermissions),
+				Fixable: true,
+				Data:    map[string]string{DataKeySetting: "default_workflow_permissions"},
+			})
+		}
+	}
+
+	return issues, nil
+}
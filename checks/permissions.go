@@ -0,0 +1,179 @@
+package checks
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// writeOperationRegexes match run commands that use the token to write to the repository
+var writeOperationRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`\bgit\s+push\b`),
+	regexp.MustCompile(`\bgh\s+release\s+(create|upload|edit|delete)\b`),
+	regexp.MustCompile(`\bgh\s+(pr|issue)\s+(create|merge|comment|edit|close|reopen|review)\b`),
+	regexp.MustCompile(`\bgh\s+workflow\s+(run|enable|disable)\b`),
+	regexp.MustCompile(`\bgh\s+api\b[^\n]*(-X|--method)\s*=?\s*['"]?(POST|PUT|PATCH|DELETE)\b`),
+	// gh api sends a POST when fields are given
+	regexp.MustCompile(`\bgh\s+api\b[^\n]*\s(-f|-F|--field|--raw-field)\s`),
+}
+
+// writeActions are actions that write to the repository with the token
+var writeActions = []string{
+	"actions/create-release",
+	"actions/github-script",
+	"actions/upload-release-asset",
+	"ad-m/github-push-action",
+	"changesets/action",
+	"EndBug/add-and-commit",
+	"google-github-actions/release-please-action",
+	"googleapis/release-please-action",
+	"goreleaser/goreleaser-action",
+	"JamesIves/github-pages-deploy-action",
+	"peaceiris/actions-gh-pages",
+	"peter-evans/create-pull-request",
+	"release-drafter/release-drafter",
+	"softprops/action-gh-release",
+	"stefanzweifel/git-auto-commit-action",
+}
+
+// broadWritePermission returns the broad write permission declared by a permissions
+// block: "write-all", "contents: write", or "" if neither is declared
+func broadWritePermission(permissions any) string {
+	switch p := permissions.(type) {
+	case string:
+		if p == "write-all" {
+			return "write-all"
+		}
+	case map[string]any:
+		if p["contents"] == "write" {
+			return "contents: write"
+		}
+	}
+	return ""
+}
+
+// grantsIDToken reports whether a permissions block grants id-token: write, which
+// write-all includes
+func grantsIDToken(permissions any) bool {
+	switch p := permissions.(type) {
+	case string:
+		return p == "write-all"
+	case map[string]any:
+		return p["id-token"] == "write"
+	}
+	return false
+}
+
+// stepWrites reports whether a step appears to write to the repository
+func stepWrites(step github.WorkflowStep) bool {
+	if step.Uses != "" {
+		action, _, _ := strings.Cut(step.Uses, "@")
+		if slices.ContainsFunc(writeActions, func(a string) bool { return strings.EqualFold(a, action) }) {
+			return true
+		}
+	}
+	for _, re := range writeOperationRegexes {
+		if re.MatchString(step.Run) {
+			return true
+		}
+	}
+	return false
+}
+
+// jobWrites reports whether a job appears to write to the repository. Jobs calling a
+// reusable workflow are assumed to write, as their steps are not visible.
+func jobWrites(job github.WorkflowJob) bool {
+	return job.Uses != "" || slices.ContainsFunc(job.Steps, stepWrites)
+}
+
+// checkUnusedWritePermissions reports, as information, broad write permissions declared
+// for jobs whose steps only appear to read. Detection is heuristic: a step may write
+// through a script or an action that is not recognized.
+func (c *ActionsCheck) checkUnusedWritePermissions(wfPath string, wf *github.Workflow) []Issue {
+	var issues []Issue
+
+	report := func(subject, permission string) {
+		issues = append(issues, Issue{
+			Type:     c.Type(),
+			Name:     c.Name(),
+			File:     wfPath,
+			Message:  fmt.Sprintf("%s declares '%s' but no step appears to write to the repository; read permissions may suffice", subject, permission),
+			Fixable:  false,
+			Severity: SeverityInfo,
+		})
+	}
+
+	// Workflow-level permissions apply to jobs that do not declare their own
+	if permission := broadWritePermission(wf.Permissions); permission != "" {
+		inheriting, writes := 0, false
+		for _, job := range wf.Jobs {
+			if job.Permissions != nil {
+				continue
+			}
+			inheriting++
+			writes = writes || jobWrites(job)
+		}
+		if inheriting > 0 && !writes {
+			report(fmt.Sprintf("Workflow '%s'", wfPath), permission)
+		}
+	}
+
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		job := wf.Jobs[jobName]
+		if permission := broadWritePermission(job.Permissions); permission != "" && !jobWrites(job) {
+			report(fmt.Sprintf("Job '%s' in '%s'", jobName, wfPath), permission)
+		}
+	}
+
+	return issues
+}
+
+// checkOIDCPermissions reports jobs matching oidc_jobs that are not granted id-token: write,
+// and other jobs that are. Jobs without their own permissions inherit the workflow's.
+func (c *ActionsCheck) checkOIDCPermissions(wfPath string, wf *github.Workflow) ([]Issue, error) {
+	globs := make([]glob.Glob, 0, len(c.config.OIDCJobs))
+	for _, pattern := range c.config.OIDCJobs {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid oidc_jobs pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+
+	var issues []Issue
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		job := wf.Jobs[jobName]
+
+		permissions, source := job.Permissions, ""
+		if permissions == nil {
+			permissions, source = wf.Permissions, " (inherited from workflow permissions)"
+		}
+		granted := grantsIDToken(permissions)
+		needed := slices.ContainsFunc(globs, func(g glob.Glob) bool { return g.Match(jobName) })
+
+		switch {
+		case needed && !granted:
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: fmt.Sprintf("Job '%s' in '%s' matches oidc_jobs but is not granted 'id-token: write'", jobName, wfPath),
+				Fixable: false,
+			})
+		case !needed && granted:
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: fmt.Sprintf("Job '%s' in '%s' is granted 'id-token: write'%s but does not match oidc_jobs", jobName, wfPath, source),
+				Fixable: false,
+			})
+		}
+	}
+	return issues, nil
+}
@@ -0,0 +1,57 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestStepWrites(t *testing.T) {
+	tests := []struct {
+		name string
+		step github.WorkflowStep
+		want bool
+	}{
+		{"checkout", github.WorkflowStep{Uses: "actions/checkout@v4"}, false},
+		{"build", github.WorkflowStep{Run: "go build ./...\ngo test ./..."}, false},
+		{"git push", github.WorkflowStep{Run: "git commit -am update\ngit push origin HEAD"}, true},
+		{"release", github.WorkflowStep{Run: "gh release create v1.0.0 dist/*"}, true},
+		{"release view", github.WorkflowStep{Run: "gh release view v1.0.0"}, false},
+		{"api get", github.WorkflowStep{Run: "gh api repos/o/r/pulls"}, false},
+		{"api post", github.WorkflowStep{Run: "gh api -X POST repos/o/r/dispatches"}, true},
+		{"api method", github.WorkflowStep{Run: "gh api --method=PATCH repos/o/r"}, true},
+		{"api field", github.WorkflowStep{Run: "gh api repos/o/r/labels -f name=bug"}, true},
+		{"release action", github.WorkflowStep{Uses: "softprops/action-gh-release@v2"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stepWrites(tt.step); got != tt.want {
+				t.Errorf("stepWrites(%+v) = %v, want %v", tt.step, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrantsIDToken(t *testing.T) {
+	tests := []struct {
+		name        string
+		permissions any
+		want        bool
+	}{
+		{"unset", nil, false},
+		{"write-all", "write-all", true},
+		{"read-all", "read-all", false},
+		{"id-token write", map[string]any{"id-token": "write", "contents": "read"}, true},
+		{"id-token none", map[string]any{"id-token": "none"}, false},
+		{"other permissions", map[string]any{"contents": "write"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grantsIDToken(tt.permissions); got != tt.want {
+				t.Errorf("grantsIDToken(%v) = %v, want %v", tt.permissions, got, tt.want)
+			}
+		})
+	}
+}
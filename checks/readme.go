@@ -0,0 +1,132 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// readmeDirs are the directories GitHub reads a README from, in the order it looks in
+// them; "" is the repository root
+var readmeDirs = []string{".github", "", "docs"}
+
+// ReadmeCheck validates that the repository README exists and contains required sections
+type ReadmeCheck struct {
+	client  *github.Client
+	config  *config.ReadmeConfig
+	verbose bool
+}
+
+// NewReadmeCheck creates a new readme check
+func NewReadmeCheck(client *github.Client, cfg *config.ReadmeConfig, verbose bool) *ReadmeCheck {
+	return &ReadmeCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *ReadmeCheck) Type() CheckType {
+	return CheckTypeReadme
+}
+
+// Name returns the check name
+func (c *ReadmeCheck) Name() string {
+	return "readme"
+}
+
+// Run executes the readme check
+func (c *ReadmeCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil {
+		return nil, nil
+	}
+
+	readmePath, err := c.findReadme()
+	if err != nil {
+		return nil, err
+	}
+	if readmePath == "" {
+		return []Issue{{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: "README does not exist (checked README.md and README.rst)",
+			Fixable: false,
+		}}, nil
+	}
+
+	content, err := c.client.GetLocalFileContent(readmePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", readmePath, err)
+	}
+
+	var headings []string
+	if strings.EqualFold(path.Ext(readmePath), ".rst") {
+		headings = rstHeadings(string(content))
+	} else {
+		headings = markdownHeadings(string(content))
+	}
+
+	required := make([]string, 0, len(c.config.RequiredSections))
+	for _, section := range c.config.RequiredSections {
+		required = append(required, strings.TrimSpace(strings.TrimLeft(section, "#")))
+	}
+
+	var issues []Issue
+	for _, section := range missingHeadings(headings, required) {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			File:    readmePath,
+			Message: fmt.Sprintf("README '%s' is missing section '%s'", readmePath, section),
+			Fixable: false,
+		})
+	}
+	return issues, nil
+}
+
+// findReadme returns the path of the README GitHub displays, matching README.md or
+// README.rst case-insensitively, or "" if there is none
+func (c *ReadmeCheck) findReadme() (string, error) {
+	for _, dir := range readmeDirs {
+		names, err := c.client.ListLocalFiles(dir)
+		if err != nil {
+			return "", fmt.Errorf("failed to list %s: %w", dir, err)
+		}
+		for _, name := range names {
+			if strings.EqualFold(name, "README.md") || strings.EqualFold(name, "README.rst") {
+				return path.Join(dir, name), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// rstHeadings returns the text of the section titles in reStructuredText: a line followed
+// by an underline of one repeated punctuation character at least as long as the title
+func rstHeadings(rst string) []string {
+	lines := strings.Split(strings.ReplaceAll(rst, "\r\n", "\n"), "\n")
+	var headings []string
+	for i := 0; i+1 < len(lines); i++ {
+		title := strings.TrimSpace(lines[i])
+		underline := strings.TrimRight(lines[i+1], " \t")
+		if title == "" || isRSTAdornment(title) || !isRSTAdornment(underline) || len(underline) < len(title) {
+			continue
+		}
+		headings = append(headings, title)
+		i++
+	}
+	return headings
+}
+
+// isRSTAdornment reports whether line is a section adornment, such as "=====" or "-----"
+func isRSTAdornment(line string) bool {
+	if len(line) < 2 || !strings.ContainsRune("=-`:'\"~^_*+#<>.", rune(line[0])) {
+		return false
+	}
+	return strings.Trim(line, line[:1]) == ""
+}
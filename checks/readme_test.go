@@ -0,0 +1,33 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRSTHeadings(t *testing.T) {
+	rst := `=======
+Project
+=======
+
+Introduction text.
+
+Getting Started
+---------------
+
+Run the installer.
+
+Runbook
+~~~~
+
+Too short an underline is not a title.
+
+* a list
+* item
+`
+
+	want := []string{"Project", "Getting Started"}
+	if got := rstHeadings(rst); !slices.Equal(got, want) {
+		t.Errorf("rstHeadings() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,125 @@
+package checks
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// reusableWorkflowInputIssues returns the caller's `with:` keys that the callee does not
+// declare, and the callee's required inputs that the caller does not provide, both sorted
+func reusableWorkflowInputIssues(with map[string]any, inputs map[string]bool) (unknown, missing []string) {
+	for _, name := range sortedKeys(with) {
+		if _, ok := inputs[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	for _, name := range sortedKeys(inputs) {
+		if _, ok := with[name]; inputs[name] && !ok {
+			missing = append(missing, name)
+		}
+	}
+	return unknown, missing
+}
+
+// fetchReusableWorkflow fetches the workflow called by a job's `uses:`, either a local
+// ./.github/workflows/x.yml or a remote owner/repo/.github/workflows/x.yml@ref. It returns
+// nil if the callee does not exist or cannot be read with the current token.
+func (c *ActionsCheck) fetchReusableWorkflow(uses string) (*github.Workflow, error) {
+	var content []byte
+	var err error
+	if local, ok := strings.CutPrefix(uses, "./"); ok {
+		content, err = c.client.GetLocalFileContent(local)
+	} else {
+		remote, parseErr := github.ParseRemoteReference(uses)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+		content, err = c.client.GetRemoteFileContentAtRef(remote.Owner, remote.Repo, remote.Path, remote.Ref)
+	}
+	if err != nil {
+		if github.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to fetch reusable workflow %s: %w", uses, err)
+	}
+
+	var wf github.Workflow
+	if err := yaml.Unmarshal(content, &wf); err != nil {
+		return nil, fmt.Errorf("invalid reusable workflow %s: %w", uses, err)
+	}
+	return &wf, nil
+}
+
+// checkReusableWorkflowInputs reports jobs calling a reusable workflow with `with:` inputs
+// the callee does not declare under on.workflow_call.inputs, or without its required inputs.
+// Actions silently ignores unknown inputs, so a typo'd input name otherwise goes unnoticed.
+// Callees that cannot be fetched are reported as warnings.
+func (c *ActionsCheck) checkReusableWorkflowInputs(wfPath string, wf *github.Workflow) ([]Issue, error) {
+	var issues []Issue
+
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		job := wf.Jobs[jobName]
+		if !strings.Contains(job.Uses, ".github/workflows/") || strings.Contains(job.Uses, "${{") {
+			continue
+		}
+
+		callee, err := c.fetchReusableWorkflow(job.Uses)
+		if err != nil {
+			return nil, err
+		}
+		if callee == nil {
+			issues = append(issues, Issue{
+				Type:     c.Type(),
+				Name:     c.Name(),
+				Severity: SeverityWarning,
+				File:     wfPath,
+				Message:  fmt.Sprintf("Job '%s' in '%s' calls reusable workflow '%s', which could not be fetched to validate its inputs", jobName, wfPath, job.Uses),
+				Fixable:  false,
+			})
+			continue
+		}
+
+		inputs, ok := callee.WorkflowCallInputs()
+		if !ok {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: fmt.Sprintf("Job '%s' in '%s' calls '%s', which has no workflow_call trigger", jobName, wfPath, job.Uses),
+				Fixable: false,
+			})
+			continue
+		}
+
+		unknown, missing := reusableWorkflowInputIssues(job.With, inputs)
+		declared := sortedKeys(inputs)
+		for _, name := range unknown {
+			message := fmt.Sprintf("Job '%s' in '%s' passes input '%s', which reusable workflow '%s' does not declare", jobName, wfPath, name, job.Uses)
+			if len(declared) > 0 {
+				message += fmt.Sprintf(" (declared: %s)", strings.Join(declared, ", "))
+			}
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: message,
+				Fixable: false,
+			})
+		}
+		for _, name := range missing {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: fmt.Sprintf("Job '%s' in '%s' does not pass required input '%s' of reusable workflow '%s'", jobName, wfPath, name, job.Uses),
+				Fixable: false,
+			})
+		}
+	}
+
+	return issues, nil
+}
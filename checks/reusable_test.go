@@ -0,0 +1,31 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestReusableWorkflowInputIssues(t *testing.T) {
+	inputs := map[string]bool{"environment": true, "dry-run": false}
+
+	tests := []struct {
+		name        string
+		with        map[string]any
+		wantUnknown []string
+		wantMissing []string
+	}{
+		{"all inputs", map[string]any{"environment": "prod", "dry-run": true}, nil, nil},
+		{"optional omitted", map[string]any{"environment": "prod"}, nil, nil},
+		{"typo", map[string]any{"enviroment": "prod"}, []string{"enviroment"}, []string{"environment"}},
+		{"no with", nil, nil, []string{"environment"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unknown, missing := reusableWorkflowInputIssues(tt.with, inputs)
+			if !slices.Equal(unknown, tt.wantUnknown) || !slices.Equal(missing, tt.wantMissing) {
+				t.Errorf("reusableWorkflowInputIssues() = %v, %v, want %v, %v", unknown, missing, tt.wantUnknown, tt.wantMissing)
+			}
+		})
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
 
 	"github.com/sethrylan/gh-repolint/config"
 	"github.com/sethrylan/gh-repolint/github"
@@ -41,16 +45,20 @@ func (c *RulesetsCheck) Run(ctx context.Context) ([]Issue, error) {
 		return nil, nil
 	}
 
-	if c.config.Reference == "" {
+	if c.config.Reference == "" && !c.hasInlineAssertions() {
 		return nil, fmt.Errorf("ruleset '%s' missing required reference field", c.config.Name)
 	}
 
 	var issues []Issue
 
 	// Fetch the expected ruleset JSON from reference
-	expectedRuleset, err := github.FetchReferenceRuleset(c.config.Reference, c.client)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch reference ruleset: %w", err)
+	var expectedRuleset *github.Ruleset
+	if c.config.Reference != "" {
+		var err error
+		expectedRuleset, err = github.FetchReferenceRuleset(c.config.Reference, c.client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reference ruleset: %w", err)
+		}
 	}
 
 	// Fetch all rulesets from the repository
@@ -78,7 +86,7 @@ func (c *RulesetsCheck) Run(ctx context.Context) ([]Issue, error) {
 			Type:    c.Type(),
 			Name:    c.Name(),
 			Message: fmt.Sprintf("Ruleset '%s' does not exist", c.config.Name),
-			Fixable: true,
+			Fixable: c.config.Reference != "", // Creating a ruleset requires a full reference
 			Data: map[string]string{
 				DataKeyRulesetName: c.config.Name,
 				DataKeyReference:   c.config.Reference,
@@ -88,25 +96,267 @@ func (c *RulesetsCheck) Run(ctx context.Context) ([]Issue, error) {
 	}
 
 	// Compare the actual ruleset with the expected ruleset from reference
-	if !c.rulesetsMatch(matchingRuleset, expectedRuleset) {
+	if expectedRuleset != nil {
+		repo, err := c.client.GetRepository()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch repository: %w", err)
+		}
+		if !c.rulesetsMatch(matchingRuleset, expectedRuleset, repo.DefaultBranch) {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Ruleset '%s' does not match reference '%s'", c.config.Name, c.config.Reference),
+				Fixable: true,
+				Data: map[string]string{
+					DataKeyRulesetName: c.config.Name,
+					DataKeyReference:   c.config.Reference,
+				},
+			})
+		}
+	}
+
+	// Check enforcement level
+	if c.config.Enforcement != "" && matchingRuleset.Enforcement != c.config.Enforcement {
 		issues = append(issues, Issue{
 			Type:    c.Type(),
 			Name:    c.Name(),
-			Message: fmt.Sprintf("Ruleset '%s' does not match reference '%s'", c.config.Name, c.config.Reference),
+			Message: fmt.Sprintf("Ruleset '%s' is in %s mode but should be %s", c.config.Name, matchingRuleset.Enforcement, c.config.Enforcement),
 			Fixable: true,
 			Data: map[string]string{
 				DataKeyRulesetName: c.config.Name,
-				DataKeyReference:   c.config.Reference,
+				DataKeyEnforcement: c.config.Enforcement,
 			},
 		})
 	}
 
+	// Check tag protection rules
+	if c.config.TagProtection != nil {
+		issues = append(issues, c.checkTagProtection(matchingRuleset)...)
+	}
+
+	// Check for bypass actors that are not allowed
+	if c.config.AllowedBypassActors != nil {
+		issues = append(issues, c.checkBypassActors(matchingRuleset)...)
+	}
+
+	// Check bypass modes
+	if c.config.RequireBypassMode != "" {
+		issues = append(issues, c.checkBypassMode(matchingRuleset)...)
+	}
+
+	// Check for bypass grants that have expired
+	if c.config.BypassExpiries != "" {
+		expiryIssues, err := c.checkBypassExpiries(matchingRuleset)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, expiryIssues...)
+	}
+
+	return issues, nil
+}
+
+// hasInlineAssertions reports whether the config asserts individual ruleset
+// properties that can be checked without a reference
+func (c *RulesetsCheck) hasInlineAssertions() bool {
+	return c.config.Enforcement != "" || c.config.TagProtection != nil || c.config.AllowedBypassActors != nil ||
+		c.config.RequireBypassMode != "" || c.config.BypassExpiries != ""
+}
+
+// checkBypassActors reports each live bypass actor that is not in allowed_bypass_actors
+func (c *RulesetsCheck) checkBypassActors(ruleset *github.Ruleset) []Issue {
+	var issues []Issue
+
+	for _, actor := range ruleset.BypassActors {
+		if bypassActorAllowed(actor, c.config.AllowedBypassActors) {
+			continue
+		}
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Ruleset '%s' has unexpected bypass actor %s %d (bypass mode: %s)", c.config.Name, actor.ActorType, actor.ActorID, actor.BypassMode),
+			Fixable: false,
+		})
+	}
+
+	return issues
+}
+
+// checkBypassMode reports each live bypass actor whose bypass mode is not require_bypass_mode,
+// e.g. an actor that can bypass "always" rather than only through a pull request
+func (c *RulesetsCheck) checkBypassMode(ruleset *github.Ruleset) []Issue {
+	var issues []Issue
+
+	for _, actor := range ruleset.BypassActors {
+		if actor.BypassMode == c.config.RequireBypassMode {
+			continue
+		}
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Ruleset '%s' bypass actor %s %d has bypass mode %s but should be %s", c.config.Name, actor.ActorType, actor.ActorID, actor.BypassMode, c.config.RequireBypassMode),
+			Fixable: false,
+		})
+	}
+
+	return issues
+}
+
+// bypassGrant is an entry in a bypass_expiries registry: a temporary bypass actor and the
+// last day its grant is valid
+type bypassGrant struct {
+	config.BypassActorConfig `yaml:",inline"`
+	Expires                  string `yaml:"expires"`
+}
+
+// bypassGrantDateLayout is the format of bypassGrant.Expires
+const bypassGrantDateLayout = "2006-01-02"
+
+// checkBypassExpiries reports each live bypass actor whose grant in the bypass_expiries
+// registry has expired. Actors without a grant are left to allowed_bypass_actors.
+func (c *RulesetsCheck) checkBypassExpiries(ruleset *github.Ruleset) ([]Issue, error) {
+	content, err := github.ResolveReferenceFile(c.config.BypassExpiries, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch bypass_expiries: %w", err)
+	}
+	var grants []bypassGrant
+	if err := yaml.Unmarshal(content, &grants); err != nil {
+		return nil, fmt.Errorf("failed to parse bypass_expiries %s: %w", c.config.BypassExpiries, err)
+	}
+
+	var issues []Issue
+	for _, actor := range ruleset.BypassActors {
+		expires, err := expiredBypassGrant(actor, grants, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("invalid bypass_expiries %s: %w", c.config.BypassExpiries, err)
+		}
+		if expires == "" {
+			continue
+		}
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Ruleset '%s' bypass actor %s %d was granted until %s; remove it from the ruleset", c.config.Name, actor.ActorType, actor.ActorID, expires),
+			Fixable: false,
+		})
+	}
+
 	return issues, nil
 }
 
+// expiredBypassGrant returns the expiry date of the grant matching actor when it has
+// expired by now, or "" when the actor has no grant or an unexpired one. An actor matching
+// several grants is expired only when all of them are.
+func expiredBypassGrant(actor github.BypassActor, grants []bypassGrant, now time.Time) (string, error) {
+	expired := ""
+	today := now.UTC().Format(bypassGrantDateLayout)
+	for _, grant := range grants {
+		if _, err := time.Parse(bypassGrantDateLayout, grant.Expires); err != nil {
+			return "", fmt.Errorf("expires %q for %s must be a YYYY-MM-DD date", grant.Expires, grant.ActorType)
+		}
+		if !bypassActorAllowed(actor, []config.BypassActorConfig{grant.BypassActorConfig}) {
+			continue
+		}
+		if grant.Expires >= today {
+			return "", nil
+		}
+		expired = max(expired, grant.Expires)
+	}
+	return expired, nil
+}
+
+// bypassActorAllowed reports whether an actor matches any allowed actor by type and,
+// when the allowed entry sets one, by ID
+func bypassActorAllowed(actor github.BypassActor, allowed []config.BypassActorConfig) bool {
+	for _, a := range allowed {
+		if a.ActorType == actor.ActorType && (a.ActorID == nil || *a.ActorID == actor.ActorID) {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultTagProtectionRules are the rule types required when tag_protection.rules is not set
+var defaultTagProtectionRules = []string{"update", "deletion"}
+
+// checkTagProtection verifies the ruleset targets tags matching the configured
+// pattern and restricts them with the required rule types
+func (c *RulesetsCheck) checkTagProtection(ruleset *github.Ruleset) []Issue {
+	var issues []Issue
+	tp := c.config.TagProtection
+
+	if ruleset.Target != "tag" {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Ruleset '%s' targets %s but tag protection requires target 'tag'", c.config.Name, ruleset.Target),
+			Fixable: false,
+		})
+		return issues
+	}
+
+	if !tagPatternCovered(ruleset.Conditions, tp.Pattern) {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Ruleset '%s' does not include tags matching '%s'", c.config.Name, tp.Pattern),
+			Fixable: false,
+		})
+	}
+
+	requiredRules := tp.Rules
+	if len(requiredRules) == 0 {
+		requiredRules = defaultTagProtectionRules
+	}
+
+	ruleTypes := make(map[string]bool)
+	for _, rule := range ruleset.Rules {
+		ruleTypes[rule.Type] = true
+	}
+
+	for _, ruleType := range requiredRules {
+		if !ruleTypes[ruleType] {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Ruleset '%s' is missing '%s' rule for tags matching '%s'", c.config.Name, ruleType, tp.Pattern),
+				Fixable: false,
+			})
+		}
+	}
+
+	return issues
+}
+
+// tagPatternCovered reports whether the ruleset conditions include the tag pattern,
+// either directly, with a refs/tags/ prefix, or via ~ALL
+func tagPatternCovered(conditions *github.RulesetConditions, pattern string) bool {
+	if conditions == nil || conditions.RefName == nil {
+		return false
+	}
+
+	candidates := map[string]bool{
+		pattern:                true,
+		"refs/tags/" + pattern: true,
+	}
+
+	for _, exclude := range conditions.RefName.Exclude {
+		if candidates[exclude] {
+			return false
+		}
+	}
+
+	for _, include := range conditions.RefName.Include {
+		if include == "~ALL" || candidates[include] {
+			return true
+		}
+	}
+	return false
+}
+
 // rulesetsMatch compares two rulesets for equivalence
 // It compares the fields that matter for configuration, ignoring ID and other runtime fields
-func (c *RulesetsCheck) rulesetsMatch(actual, expected *github.Ruleset) bool {
+func (c *RulesetsCheck) rulesetsMatch(actual, expected *github.Ruleset, defaultBranch string) bool {
 	// Compare enforcement
 	if actual.Enforcement != expected.Enforcement {
 		return false
@@ -118,7 +368,7 @@ func (c *RulesetsCheck) rulesetsMatch(actual, expected *github.Ruleset) bool {
 	}
 
 	// Compare conditions
-	if !conditionsMatch(actual.Conditions, expected.Conditions) {
+	if !conditionsMatch(actual.Conditions, expected.Conditions, defaultBranch) {
 		return false
 	}
 
@@ -136,7 +386,8 @@ func (c *RulesetsCheck) rulesetsMatch(actual, expected *github.Ruleset) bool {
 }
 
 // conditionsMatch compares ruleset conditions
-func conditionsMatch(actual, expected *github.RulesetConditions) bool {
+// Ref names are compared as sets after resolving ~DEFAULT_BRANCH against defaultBranch
+func conditionsMatch(actual, expected *github.RulesetConditions, defaultBranch string) bool {
 	if actual == nil && expected == nil {
 		return true
 	}
@@ -152,16 +403,31 @@ func conditionsMatch(actual, expected *github.RulesetConditions) bool {
 		return false
 	}
 
-	if !stringSlicesEqual(actual.RefName.Include, expected.RefName.Include) {
+	if !stringSlicesEqual(normalizeRefNames(actual.RefName.Include, defaultBranch), normalizeRefNames(expected.RefName.Include, defaultBranch)) {
 		return false
 	}
-	if !stringSlicesEqual(actual.RefName.Exclude, expected.RefName.Exclude) {
+	if !stringSlicesEqual(normalizeRefNames(actual.RefName.Exclude, defaultBranch), normalizeRefNames(expected.RefName.Exclude, defaultBranch)) {
 		return false
 	}
 
 	return true
 }
 
+// normalizeRefNames resolves the ~DEFAULT_BRANCH token to refs/heads/<defaultBranch>
+// and returns the ref names sorted and deduplicated. ~ALL has no concrete expansion
+// and is kept as-is.
+func normalizeRefNames(refs []string, defaultBranch string) []string {
+	normalized := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if ref == "~DEFAULT_BRANCH" && defaultBranch != "" {
+			ref = "refs/heads/" + defaultBranch
+		}
+		normalized = append(normalized, ref)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
+
 // rulesMatch compares ruleset rules
 func rulesMatch(actual, expected []github.RulesetRule) bool {
 	if len(actual) != len(expected) {
@@ -0,0 +1,131 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestConditionsMatch_DefaultBranchToken(t *testing.T) {
+	conditions := func(include ...string) *github.RulesetConditions {
+		return &github.RulesetConditions{RefName: &github.RefNameCondition{Include: include, Exclude: []string{}}}
+	}
+
+	tests := []struct {
+		name     string
+		actual   *github.RulesetConditions
+		expected *github.RulesetConditions
+		want     bool
+	}{
+		{"token matches resolved branch", conditions("refs/heads/main"), conditions("~DEFAULT_BRANCH"), true},
+		{"resolved branch matches token", conditions("~DEFAULT_BRANCH"), conditions("refs/heads/main"), true},
+		{"token does not match other branch", conditions("refs/heads/master"), conditions("~DEFAULT_BRANCH"), false},
+		{"all token is literal", conditions("~ALL"), conditions("~ALL"), true},
+		{"order is ignored", conditions("refs/heads/release", "refs/heads/main"), conditions("~DEFAULT_BRANCH", "refs/heads/release"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := conditionsMatch(tt.actual, tt.expected, "main"); got != tt.want {
+				t.Errorf("conditionsMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBypassActorAllowed(t *testing.T) {
+	teamID := 42
+	allowed := []config.BypassActorConfig{
+		{ActorType: "Team", ActorID: &teamID},
+		{ActorType: "OrganizationAdmin"},
+	}
+
+	tests := []struct {
+		name  string
+		actor github.BypassActor
+		want  bool
+	}{
+		{"allowed team", github.BypassActor{ActorType: "Team", ActorID: 42}, true},
+		{"other team", github.BypassActor{ActorType: "Team", ActorID: 7}, false},
+		{"any organization admin", github.BypassActor{ActorType: "OrganizationAdmin", ActorID: 1}, true},
+		{"integration not listed", github.BypassActor{ActorType: "Integration", ActorID: 42}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bypassActorAllowed(tt.actor, allowed); got != tt.want {
+				t.Errorf("bypassActorAllowed(%+v) = %v, want %v", tt.actor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtraRulesets(t *testing.T) {
+	rulesets := []github.Ruleset{
+		{Name: "main"},
+		{Name: "tags"},
+		{Name: "legacy"},
+		{Name: "org-baseline", SourceType: "Organization"},
+	}
+
+	got := extraRulesets(rulesets, []string{"main", "tags"})
+	if want := []string{"legacy"}; !slices.Equal(got, want) {
+		t.Errorf("extraRulesets() = %v, want %v", got, want)
+	}
+}
+
+func TestExpiredBypassGrant(t *testing.T) {
+	registry := `
+- actor_type: Team
+  actor_id: 1234
+  expires: 2026-03-31
+- actor_type: Team
+  actor_id: 5678
+  expires: 2026-03-31
+- actor_type: Team
+  actor_id: 5678
+  expires: 2026-12-31
+- actor_type: Integration
+  expires: 2026-01-15
+`
+	var grants []bypassGrant
+	if err := yaml.Unmarshal([]byte(registry), &grants); err != nil {
+		t.Fatalf("failed to parse registry: %v", err)
+	}
+	now := time.Date(2026, 4, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		actor github.BypassActor
+		want  string
+	}{
+		{"expired", github.BypassActor{ActorType: "Team", ActorID: 1234}, "2026-03-31"},
+		{"renewed grant", github.BypassActor{ActorType: "Team", ActorID: 5678}, ""},
+		{"no grant", github.BypassActor{ActorType: "Team", ActorID: 9999}, ""},
+		{"any actor of type", github.BypassActor{ActorType: "Integration", ActorID: 42}, "2026-01-15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := expiredBypassGrant(tt.actor, grants, now)
+			if err != nil {
+				t.Fatalf("expiredBypassGrant() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expiredBypassGrant() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := expiredBypassGrant(tests[0].actor, []bypassGrant{{Expires: "31/03/2026"}}, now); err == nil {
+		t.Error("expiredBypassGrant() should reject an invalid expires date")
+	}
+	if got, _ := expiredBypassGrant(tests[0].actor, grants, time.Date(2026, 3, 31, 23, 0, 0, 0, time.UTC)); got != "" {
+		t.Errorf("grant should be valid through its expires date, got %q", got)
+	}
+}
@@ -0,0 +1,110 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// RulesetSetCheck validates that the repository's rulesets are exactly the set of rulesets
+// in a reference directory
+type RulesetSetCheck struct {
+	client  *github.Client
+	config  *config.RulesetSetConfig
+	verbose bool
+}
+
+// NewRulesetSetCheck creates a new ruleset set check
+func NewRulesetSetCheck(client *github.Client, cfg *config.RulesetSetConfig, verbose bool) *RulesetSetCheck {
+	return &RulesetSetCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *RulesetSetCheck) Type() CheckType {
+	return CheckTypeRulesets
+}
+
+// Name returns the check name
+func (c *RulesetSetCheck) Name() string {
+	return "ruleset_set"
+}
+
+// Run executes the ruleset set check. Each reference ruleset is checked like a configured
+// ruleset, so its issues are fixed the same way; rulesets on the repository that are not in
+// the set are reported but not fixed.
+func (c *RulesetSetCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil || c.config.Reference == "" {
+		return nil, nil
+	}
+
+	refs, err := github.ResolveReferenceDir(c.config.Reference, ".json", c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ruleset_set reference: %w", err)
+	}
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("ruleset_set reference '%s' contains no ruleset JSON files", c.config.Reference)
+	}
+
+	var issues []Issue
+	var names []string
+
+	for _, ref := range refs {
+		expected, err := github.FetchReferenceRuleset(ref, c.client)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch reference ruleset '%s': %w", ref, err)
+		}
+		if expected.Name == "" {
+			return nil, fmt.Errorf("reference ruleset '%s' has no name", ref)
+		}
+		if slices.Contains(names, expected.Name) {
+			return nil, fmt.Errorf("ruleset_set reference '%s' contains more than one ruleset named '%s'", c.config.Reference, expected.Name)
+		}
+		names = append(names, expected.Name)
+
+		check := NewRulesetsCheck(c.client, &config.RulesetConfig{Name: expected.Name, Reference: ref}, c.verbose)
+		rulesetIssues, err := check.Run(ctx)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, rulesetIssues...)
+	}
+
+	rulesets, err := c.client.GetRulesets()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rulesets: %w", err)
+	}
+
+	for _, name := range extraRulesets(rulesets, names) {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Ruleset '%s' is not in ruleset_set '%s'", name, c.config.Reference),
+			Fixable: false,
+		})
+	}
+
+	return issues, nil
+}
+
+// extraRulesets returns the names of the repository's own rulesets that are not in names.
+// Rulesets inherited from the organization are not managed per repository, so they are ignored.
+func extraRulesets(rulesets []github.Ruleset, names []string) []string {
+	var extra []string
+	for _, rs := range rulesets {
+		if rs.SourceType == "Organization" {
+			continue
+		}
+		if !slices.Contains(names, rs.Name) {
+			extra = append(extra, rs.Name)
+		}
+	}
+	slices.Sort(extra)
+	return extra
+}
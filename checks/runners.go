@@ -0,0 +1,73 @@
+package checks
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// matrixExpressionRegex matches a runs-on label that is a single matrix value, e.g. ${{ matrix.os }}
+var matrixExpressionRegex = regexp.MustCompile(`^\$\{\{\s*matrix\.([A-Za-z0-9_-]+)\s*\}\}$`)
+
+// jobRunnerLabels returns the job's runner labels, expanding labels taken from a matrix
+// variable to the variable's values. Other expressions cannot be evaluated and are dropped.
+func jobRunnerLabels(job github.WorkflowJob) []string {
+	var labels []string
+	for _, label := range job.RunnerLabels() {
+		if !strings.Contains(label, "${{") {
+			labels = append(labels, label)
+			continue
+		}
+		match := matrixExpressionRegex.FindStringSubmatch(label)
+		if match == nil || job.Strategy == nil {
+			continue
+		}
+		values, _ := job.Strategy.Matrix[match[1]].([]any)
+		for _, value := range values {
+			if s, ok := value.(string); ok {
+				labels = append(labels, s)
+			}
+		}
+	}
+	return labels
+}
+
+// checkRunners reports jobs running on floating "*-latest" labels with forbid_latest_runners,
+// and on labels outside pinned_runners when it is set
+func (c *ActionsCheck) checkRunners(wfPath string, wf *github.Workflow) []Issue {
+	forbidLatest := c.config.ForbidLatestRunners != nil && *c.config.ForbidLatestRunners
+
+	var issues []Issue
+	for _, jobName := range sortedKeys(wf.Jobs) {
+		reported := make(map[string]bool)
+		for _, label := range jobRunnerLabels(wf.Jobs[jobName]) {
+			if reported[label] {
+				continue
+			}
+
+			var message string
+			switch {
+			case forbidLatest && strings.HasSuffix(label, "-latest"):
+				message = fmt.Sprintf("Job '%s' in '%s' runs on floating runner label '%s'; use a versioned label (e.g. ubuntu-24.04)", jobName, wfPath, label)
+			case len(c.config.PinnedRunners) > 0 && !slices.Contains(c.config.PinnedRunners, label):
+				message = fmt.Sprintf("Job '%s' in '%s' runs on '%s', which is not in pinned_runners (%s)", jobName, wfPath, label, strings.Join(c.config.PinnedRunners, ", "))
+			default:
+				continue
+			}
+
+			reported[label] = true
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    wfPath,
+				Message: message,
+				Fixable: false,
+			})
+		}
+	}
+
+	return issues
+}
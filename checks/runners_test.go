@@ -0,0 +1,36 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestJobRunnerLabels(t *testing.T) {
+	tests := []struct {
+		name string
+		job  github.WorkflowJob
+		want []string
+	}{
+		{"plain label", github.WorkflowJob{RunsOn: "ubuntu-latest"}, []string{"ubuntu-latest"}},
+		{
+			"matrix variable",
+			github.WorkflowJob{
+				RunsOn:   "${{ matrix.os }}",
+				Strategy: &github.JobStrategy{Matrix: map[string]any{"os": []any{"ubuntu-24.04", "macos-latest"}}},
+			},
+			[]string{"ubuntu-24.04", "macos-latest"},
+		},
+		{"matrix without strategy", github.WorkflowJob{RunsOn: "${{ matrix.os }}"}, nil},
+		{"other expression", github.WorkflowJob{RunsOn: "${{ inputs.runner }}"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jobRunnerLabels(tt.job); !slices.Equal(got, tt.want) {
+				t.Errorf("jobRunnerLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package checks
+
+import "regexp"
+
+// secretDetector matches a kind of hardcoded credential
+type secretDetector struct {
+	kind    string
+	pattern *regexp.Regexp
+}
+
+// secretDetectors are the credential patterns reported by forbid_hardcoded_secrets.
+// Expressions such as ${{ secrets.X }} never match because '$' and '{' are not token characters.
+var secretDetectors = []secretDetector{
+	{kind: "GitHub token", pattern: regexp.MustCompile(`\b(?:ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36}\b`)},
+	{kind: "GitHub fine-grained token", pattern: regexp.MustCompile(`\bgithub_pat_[A-Za-z0-9_]{22,}`)},
+	{kind: "AWS access key", pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{kind: "Bearer token", pattern: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]{20,}=*`)},
+}
+
+// detectSecrets returns the kinds of hardcoded credentials found in value, in detector order
+func detectSecrets(value string) []string {
+	var kinds []string
+	for _, d := range secretDetectors {
+		if d.pattern.MatchString(value) {
+			kinds = append(kinds, d.kind)
+		}
+	}
+	return kinds
+}
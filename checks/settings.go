@@ -3,8 +3,10 @@ package checks
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/gobwas/glob"
+	"gopkg.in/yaml.v3"
 
 	"github.com/sethrylan/gh-repolint/config"
 	"github.com/sethrylan/gh-repolint/github"
@@ -12,9 +14,10 @@ import (
 
 // SettingsCheck validates repository settings
 type SettingsCheck struct {
-	client  *github.Client
-	config  *config.SettingsConfig
-	verbose bool
+	client     *github.Client
+	config     *config.SettingsConfig
+	checkLinks bool
+	verbose    bool
 }
 
 // NewSettingsCheck creates a new settings check
@@ -26,6 +29,11 @@ func NewSettingsCheck(client *github.Client, cfg *config.SettingsConfig, verbose
 	}
 }
 
+// SetCheckLinks controls whether the homepage URL is requested to confirm it resolves
+func (c *SettingsCheck) SetCheckLinks(enabled bool) {
+	c.checkLinks = enabled
+}
+
 // Type returns the check type
 func (c *SettingsCheck) Type() CheckType {
 	return CheckTypeSettings
@@ -90,6 +98,36 @@ func (c *SettingsCheck) Run(ctx context.Context) ([]Issue, error) {
 		})
 	}
 
+	if c.config.Downloads != nil && repo.HasDownloads != *c.config.Downloads {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Downloads is %s but should be %s", boolToEnabled(repo.HasDownloads), boolToEnabled(*c.config.Downloads)),
+			Fixable: true,
+			Data:    map[string]string{DataKeySetting: "downloads"},
+		})
+	}
+
+	if c.config.AllowForking != nil && repo.AllowForking != *c.config.AllowForking {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Forking is %s but should be %s", boolToAllowed(repo.AllowForking), boolToAllowed(*c.config.AllowForking)),
+			Fixable: true,
+			Data:    map[string]string{DataKeySetting: "allow_forking"},
+		})
+	}
+
+	if c.config.WebCommitSignoffRequired != nil && repo.WebCommitSignoffRequired != *c.config.WebCommitSignoffRequired {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Web commit signoff requirement is %s but should be %s", boolToEnabled(repo.WebCommitSignoffRequired), boolToEnabled(*c.config.WebCommitSignoffRequired)),
+			Fixable: true,
+			Data:    map[string]string{DataKeySetting: "web_commit_signoff_required"},
+		})
+	}
+
 	// Check actions permissions
 	if c.config.AllowActionsToApprovePRs != nil {
 		perms, err := c.client.GetWorkflowPermissions()
@@ -109,10 +147,19 @@ func (c *SettingsCheck) Run(ctx context.Context) ([]Issue, error) {
 
 	// Check merge settings
 	if c.config.Merge != nil {
-		mergeIssues := c.checkMergeSettings(repo)
+		mergeIssues := c.checkMergeSettings(repo, c.config.Merge)
 		issues = append(issues, mergeIssues...)
 	}
 
+	// Check merge settings against the matrix for the repository's tier
+	if c.config.MergeTiers != nil {
+		tierIssues, err := c.checkMergeTiers(repo)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, tierIssues...)
+	}
+
 	// Check default branch pattern
 	if c.config.DefaultBranch != "" {
 		g, err := glob.Compile(c.config.DefaultBranch)
@@ -120,10 +167,15 @@ func (c *SettingsCheck) Run(ctx context.Context) ([]Issue, error) {
 			return nil, fmt.Errorf("invalid default_branch pattern: %w", err)
 		}
 		if !g.Match(repo.DefaultBranch) {
+			message := fmt.Sprintf("Default branch '%s' does not match pattern '%s'", repo.DefaultBranch, c.config.DefaultBranch)
+			if name := suggestedBranchName(c.config.DefaultBranch); name != "" {
+				message += fmt.Sprintf(" (rename with: gh api -X POST repos/%s/%s/branches/%s/rename -f new_name=%s)",
+					c.client.Owner(), c.client.Repo(), repo.DefaultBranch, name)
+			}
 			issues = append(issues, Issue{
 				Type:    c.Type(),
 				Name:    c.Name(),
-				Message: fmt.Sprintf("Default branch '%s' does not match pattern '%s'", repo.DefaultBranch, c.config.DefaultBranch),
+				Message: message,
 				Fixable: false, // Branch renaming requires manual intervention
 			})
 		}
@@ -140,6 +192,56 @@ func (c *SettingsCheck) Run(ctx context.Context) ([]Issue, error) {
 		})
 	}
 
+	// Check homepage host
+	if len(c.config.HomepageAllowedHosts) > 0 {
+		homepageIssues, err := c.checkHomepage(repo.Homepage)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, homepageIssues...)
+	}
+
+	// Check discussion categories
+	if len(c.config.DiscussionCategories) > 0 {
+		categoryIssues, err := c.checkDiscussionCategories(repo.HasDiscussions)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, categoryIssues...)
+	}
+
+	// Check merge queue parameters
+	if c.config.MergeQueue != nil {
+		queueIssues, err := c.checkMergeQueue(repo.DefaultBranch)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, queueIssues...)
+	}
+
+	// Check repository size; shrinking a repository means rewriting history or migrating to LFS
+	if c.config.MaxSizeKB != nil && repo.Size > *c.config.MaxSizeKB {
+		issues = append(issues, Issue{
+			Type:     c.Type(),
+			Name:     c.Name(),
+			Severity: SeverityInfo,
+			Message:  fmt.Sprintf("Repository size is %d KB, exceeding the maximum of %d KB", repo.Size, *c.config.MaxSizeKB),
+			Fixable:  false,
+		})
+	}
+
+	// Check GitHub Advanced Security, which public repositories do not need
+	if c.config.AdvancedSecurity != nil && repo.Visibility != "public" {
+		issues = append(issues, c.checkSecurityFeature("advanced_security", "Advanced Security",
+			repo.SecurityAndAnalysis.AdvancedSecurity, *c.config.AdvancedSecurity)...)
+	}
+
+	// Check secret scanning for non-provider patterns
+	if c.config.SecretScanningNonProvider != nil {
+		issues = append(issues, c.checkSecurityFeature("secret_scanning_non_provider_patterns", "Secret scanning for non-provider patterns",
+			repo.SecurityAndAnalysis.SecretScanningNonProviderPatterns, *c.config.SecretScanningNonProvider)...)
+	}
+
 	// Check Dependabot settings
 	if c.config.Dependabot != nil {
 		dependabotIssues, err := c.checkDependabotSettings()
@@ -152,9 +254,38 @@ func (c *SettingsCheck) Run(ctx context.Context) ([]Issue, error) {
 	return issues, nil
 }
 
-func (c *SettingsCheck) checkMergeSettings(repo *github.Repository) []Issue {
+// checkSecurityFeature compares the status of a security and analysis feature with the config.
+// GitHub omits the status when the feature is not available on the owner's plan (or the
+// token lacks admin access), which cannot be fixed here.
+func (c *SettingsCheck) checkSecurityFeature(setting, label string, feature github.SecurityFeature, want bool) []Issue {
+	if feature.Status == "" {
+		if !want {
+			return nil
+		}
+		return []Issue{{
+			Type:     c.Type(),
+			Name:     c.Name(),
+			Severity: SeverityWarning,
+			Message:  fmt.Sprintf("%s should be enabled but is not available (not included in the plan, or the token lacks admin access)", label),
+			Fixable:  false,
+		}}
+	}
+
+	enabled := feature.Status == "enabled"
+	if enabled == want {
+		return nil
+	}
+	return []Issue{{
+		Type:    c.Type(),
+		Name:    c.Name(),
+		Message: fmt.Sprintf("%s is %s but should be %s", label, boolToEnabled(enabled), boolToEnabled(want)),
+		Fixable: true,
+		Data:    map[string]string{DataKeySetting: setting},
+	}}
+}
+
+func (c *SettingsCheck) checkMergeSettings(repo *github.Repository, merge *config.MergeConfig) []Issue {
 	var issues []Issue
-	merge := c.config.Merge
 
 	if merge.AllowMergeCommit != nil && repo.AllowMergeCommit != *merge.AllowMergeCommit {
 		issues = append(issues, Issue{
@@ -216,9 +347,91 @@ func (c *SettingsCheck) checkMergeSettings(repo *github.Repository) []Issue {
 		})
 	}
 
+	if merge.SquashMergeCommitMessage != "" && repo.SquashMergeCommitMessage != merge.SquashMergeCommitMessage {
+		issues = append(issues, Issue{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Squash merge commit message is '%s' but should be '%s'", repo.SquashMergeCommitMessage, merge.SquashMergeCommitMessage),
+			Fixable: true,
+			Data:    map[string]string{DataKeySetting: "squash_merge_commit_message"},
+		})
+	}
+
 	return issues
 }
 
+// checkMergeTiers compares the merge settings with those the merge_tiers matrix expects for
+// the tier in the repository's custom property. The fixer applies settings.merge, not the
+// matrix, so these issues are not fixable.
+func (c *SettingsCheck) checkMergeTiers(repo *github.Repository) ([]Issue, error) {
+	tiers := c.config.MergeTiers
+
+	values, err := c.client.GetCustomPropertyValues()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch custom properties: %w", err)
+	}
+	tier := customPropertyString(values, tiers.Property)
+	if tier == "" {
+		return []Issue{{
+			Type:     c.Type(),
+			Name:     c.Name(),
+			Severity: SeverityWarning,
+			Message:  fmt.Sprintf("Repository has no '%s' custom property, so its merge settings tier is unknown", tiers.Property),
+			Fixable:  false,
+		}}, nil
+	}
+
+	content, err := github.ResolveReferenceFile(tiers.Reference, c.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve merge_tiers reference: %w", err)
+	}
+	var matrix map[string]config.MergeConfig
+	if err := yaml.Unmarshal(content, &matrix); err != nil {
+		return nil, fmt.Errorf("invalid merge_tiers reference %s: %w", tiers.Reference, err)
+	}
+
+	merge, ok := matrix[tier]
+	if !ok {
+		return []Issue{{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			Message: fmt.Sprintf("Tier '%s' (custom property '%s') has no merge settings in %s (tiers: %s)", tier, tiers.Property, tiers.Reference, strings.Join(sortedKeys(matrix), ", ")),
+			Fixable: false,
+		}}, nil
+	}
+
+	issues := c.checkMergeSettings(repo, &merge)
+	for i := range issues {
+		issues[i].Message = fmt.Sprintf("Tier '%s': %s", tier, issues[i].Message)
+		issues[i].Fixable = false
+		issues[i].Data = nil
+	}
+	return issues, nil
+}
+
+// customPropertyString returns the value of the named custom property, joining the values
+// of a multi-select property with commas, or "" if it is not set
+func customPropertyString(values []github.CustomPropertyValue, name string) string {
+	for _, v := range values {
+		if v.PropertyName != name {
+			continue
+		}
+		switch value := v.Value.(type) {
+		case string:
+			return value
+		case []any:
+			var parts []string
+			for _, part := range value {
+				if s, ok := part.(string); ok {
+					parts = append(parts, s)
+				}
+			}
+			return strings.Join(parts, ",")
+		}
+	}
+	return ""
+}
+
 func boolToEnabled(b bool) string {
 	if b {
 		return "enabled"
@@ -226,6 +439,20 @@ func boolToEnabled(b bool) string {
 	return "disabled"
 }
 
+// suggestedBranchName returns the branch to rename a non-matching default branch to: the
+// pattern itself when it is a literal name, or the first alternative of a brace pattern
+// such as "{main,master}". Other patterns have no single suggestion.
+func suggestedBranchName(pattern string) string {
+	if strings.HasPrefix(pattern, "{") && strings.HasSuffix(pattern, "}") {
+		first, _, _ := strings.Cut(pattern[1:len(pattern)-1], ",")
+		pattern = first
+	}
+	if pattern == "" || strings.ContainsAny(pattern, "*?[]{}!\\,") {
+		return ""
+	}
+	return pattern
+}
+
 func boolToAllowed(b bool) string {
 	if b {
 		return "allowed"
@@ -271,5 +498,22 @@ func (c *SettingsCheck) checkDependabotSettings() ([]Issue, error) {
 		}
 	}
 
+	// Check the dependency graph, which Dependabot alerts depend on
+	if dep.DependencyGraph != nil {
+		enabled, err := c.client.GetDependencyGraphEnabled()
+		if err != nil {
+			return nil, fmt.Errorf("failed to check dependency graph: %w", err)
+		}
+		if enabled != *dep.DependencyGraph {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Dependency graph is %s but should be %s", boolToEnabled(enabled), boolToEnabled(*dep.DependencyGraph)),
+				Fixable: true,
+				Data:    map[string]string{DataKeySetting: "dependency_graph"},
+			})
+		}
+	}
+
 	return issues, nil
 }
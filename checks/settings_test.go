@@ -0,0 +1,101 @@
+package checks
+
+import (
+	"testing"
+
+	"github.com/gobwas/glob"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestDefaultBranchBracePattern(t *testing.T) {
+	g := glob.MustCompile("{main,master}")
+	for _, branch := range []string{"main", "master"} {
+		if !g.Match(branch) {
+			t.Errorf("pattern {main,master} should match %q", branch)
+		}
+	}
+	if g.Match("develop") {
+		t.Errorf("pattern {main,master} should not match %q", "develop")
+	}
+}
+
+func TestSuggestedBranchName(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    string
+	}{
+		{"main", "main"},
+		{"{main,master}", "main"},
+		{"{trunk}", "trunk"},
+		{"release-*", ""},
+		{"{main,release-*}", "main"},
+		{"{release-*,main}", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			if got := suggestedBranchName(tt.pattern); got != tt.want {
+				t.Errorf("suggestedBranchName(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckSecurityFeature(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		want     bool
+		issues   int
+		fixable  bool
+		severity Severity
+	}{
+		{"enabled as configured", "enabled", true, 0, false, SeverityError},
+		{"disabled but should be enabled", "disabled", true, 1, true, SeverityError},
+		{"enabled but should be disabled", "enabled", false, 1, true, SeverityError},
+		{"unavailable but should be enabled", "", true, 1, false, SeverityWarning},
+		{"unavailable and should be disabled", "", false, 0, false, SeverityError},
+	}
+
+	c := &SettingsCheck{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := c.checkSecurityFeature("setting", "Feature", github.SecurityFeature{Status: tt.status}, tt.want)
+			if len(issues) != tt.issues {
+				t.Fatalf("checkSecurityFeature() returned %d issues, want %d", len(issues), tt.issues)
+			}
+			if len(issues) == 1 && (issues[0].Fixable != tt.fixable || issues[0].Severity != tt.severity) {
+				t.Errorf("checkSecurityFeature() fixable = %v, severity = %v, want %v, %v", issues[0].Fixable, issues[0].Severity, tt.fixable, tt.severity)
+			}
+		})
+	}
+}
+
+func TestCustomPropertyString(t *testing.T) {
+	values := []github.CustomPropertyValue{
+		{PropertyName: "tier", Value: "critical"},
+		{PropertyName: "teams", Value: []any{"payments", "platform"}},
+		{PropertyName: "owner", Value: nil},
+	}
+
+	tests := []struct {
+		name     string
+		property string
+		want     string
+	}{
+		{"string", "tier", "critical"},
+		{"multi-select", "teams", "payments,platform"},
+		{"unset", "owner", ""},
+		{"missing", "region", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := customPropertyString(values, tt.property); got != tt.want {
+				t.Errorf("customPropertyString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/gobwas/glob"
+	"gopkg.in/yaml.v3"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// issueTemplateDir is where GitHub reads issue templates and forms from
+const issueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// pullRequestTemplatePaths are the locations GitHub reads a single pull request template from,
+// in the order it looks for them
+var pullRequestTemplatePaths = []string{
+	".github/pull_request_template.md",
+	".github/PULL_REQUEST_TEMPLATE.md",
+	"pull_request_template.md",
+	"PULL_REQUEST_TEMPLATE.md",
+	"docs/pull_request_template.md",
+	"docs/PULL_REQUEST_TEMPLATE.md",
+}
+
+// markdownHeadingRe matches an ATX heading such as "## Summary"
+var markdownHeadingRe = regexp.MustCompile(`^ {0,3}#{1,6}\s+(.*?)\s*$`)
+
+// TemplateStructureCheck validates that issue forms define required fields and that the
+// pull request template contains required headings
+type TemplateStructureCheck struct {
+	client  *github.Client
+	config  *config.TemplateStructureConfig
+	verbose bool
+}
+
+// NewTemplateStructureCheck creates a new template structure check
+func NewTemplateStructureCheck(client *github.Client, cfg *config.TemplateStructureConfig, verbose bool) *TemplateStructureCheck {
+	return &TemplateStructureCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *TemplateStructureCheck) Type() CheckType {
+	return CheckTypeTemplates
+}
+
+// Name returns the check name
+func (c *TemplateStructureCheck) Name() string {
+	return "template_structure"
+}
+
+// Run executes the template structure check
+func (c *TemplateStructureCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil {
+		return nil, nil
+	}
+
+	var issues []Issue
+
+	if len(c.config.PullRequestHeadings) > 0 {
+		templateIssues, err := c.checkPullRequestTemplate()
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, templateIssues...)
+	}
+
+	if len(c.config.IssueForms) > 0 {
+		formIssues, err := c.checkIssueForms()
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, formIssues...)
+	}
+
+	return issues, nil
+}
+
+// checkPullRequestTemplate reports required headings missing from the pull request template
+func (c *TemplateStructureCheck) checkPullRequestTemplate() ([]Issue, error) {
+	for _, templatePath := range pullRequestTemplatePaths {
+		content, err := c.client.GetLocalFileContent(templatePath)
+		if github.IsNotFound(err) || errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", templatePath, err)
+		}
+
+		var issues []Issue
+		for _, heading := range missingHeadings(markdownHeadings(string(content)), c.config.PullRequestHeadings) {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    templatePath,
+				Message: fmt.Sprintf("Pull request template '%s' is missing heading '%s'", templatePath, heading),
+			})
+		}
+		return issues, nil
+	}
+
+	return []Issue{{
+		Type:    c.Type(),
+		Name:    c.Name(),
+		Message: "Pull request template does not exist",
+	}}, nil
+}
+
+// checkIssueForms reports required field IDs missing from the issue forms matching each configured pattern
+func (c *TemplateStructureCheck) checkIssueForms() ([]Issue, error) {
+	names, err := c.client.ListLocalFiles(issueTemplateDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list issue templates: %w", err)
+	}
+	slices.Sort(names)
+
+	var issues []Issue
+	for _, form := range c.config.IssueForms {
+		pattern := form.Forms
+		if pattern == "" {
+			pattern = "*"
+		}
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid issue_forms pattern %q: %w", pattern, err)
+		}
+
+		matched := false
+		for _, name := range names {
+			if !isIssueForm(name) || !g.Match(name) {
+				continue
+			}
+			matched = true
+			issues = append(issues, c.checkIssueForm(path.Join(issueTemplateDir, name), form.RequiredFields)...)
+		}
+
+		if !matched {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("No issue form in '%s' matches '%s'", issueTemplateDir, pattern),
+			})
+		}
+	}
+	return issues, nil
+}
+
+// checkIssueForm reports required field IDs missing from one issue form
+func (c *TemplateStructureCheck) checkIssueForm(formPath string, required []string) []Issue {
+	content, err := c.client.GetLocalFileContent(formPath)
+	if err != nil {
+		return []Issue{{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			File:    formPath,
+			Message: fmt.Sprintf("Failed to read issue form '%s': %s", formPath, err),
+		}}
+	}
+
+	ids, err := issueFormFieldIDs(content)
+	if err != nil {
+		return []Issue{{
+			Type:    c.Type(),
+			Name:    c.Name(),
+			File:    formPath,
+			Message: fmt.Sprintf("Issue form '%s' is not valid YAML: %s", formPath, err),
+		}}
+	}
+
+	var issues []Issue
+	for _, id := range required {
+		if !slices.Contains(ids, id) {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				File:    formPath,
+				Message: fmt.Sprintf("Issue form '%s' is missing field '%s'", formPath, id),
+			})
+		}
+	}
+	return issues
+}
+
+// isIssueForm reports whether a file in the issue template directory is an issue form,
+// rather than a markdown template or the template chooser's config.yml
+func isIssueForm(name string) bool {
+	ext := path.Ext(name)
+	if ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+	return strings.TrimSuffix(name, ext) != "config"
+}
+
+// issueFormFieldIDs returns the IDs of the fields in an issue form's body
+func issueFormFieldIDs(content []byte) ([]string, error) {
+	var form struct {
+		Body []struct {
+			ID string `yaml:"id"`
+		} `yaml:"body"`
+	}
+	if err := yaml.Unmarshal(content, &form); err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, field := range form.Body {
+		if field.ID != "" {
+			ids = append(ids, field.ID)
+		}
+	}
+	return ids, nil
+}
+
+// markdownHeadings returns the text of the ATX headings in markdown, ignoring headings
+// inside fenced code blocks
+func markdownHeadings(markdown string) []string {
+	var headings []string
+	inFence := false
+	for line := range strings.Lines(markdown) {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if m := markdownHeadingRe.FindStringSubmatch(strings.TrimRight(line, "\r\n")); m != nil {
+			headings = append(headings, m[1])
+		}
+	}
+	return headings
+}
+
+// missingHeadings returns the required headings that are not in headings, compared case-insensitively
+func missingHeadings(headings, required []string) []string {
+	var missing []string
+	for _, heading := range required {
+		if !slices.ContainsFunc(headings, func(h string) bool { return strings.EqualFold(h, heading) }) {
+			missing = append(missing, heading)
+		}
+	}
+	return missing
+}
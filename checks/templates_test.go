@@ -0,0 +1,67 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMissingHeadings(t *testing.T) {
+	template := "## Summary\n\nDescribe the change.\n\n### closes #\n\n```md\n## Testing\n```\n"
+
+	tests := []struct {
+		name     string
+		required []string
+		want     []string
+	}{
+		{"all present", []string{"Summary"}, nil},
+		{"case insensitive", []string{"SUMMARY", "Closes #"}, nil},
+		{"missing heading", []string{"Summary", "Checklist"}, []string{"Checklist"}},
+		{"heading in code block", []string{"Testing"}, []string{"Testing"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := missingHeadings(markdownHeadings(template), tt.required); !slices.Equal(got, tt.want) {
+				t.Errorf("missingHeadings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssueFormFieldIDs(t *testing.T) {
+	form := []byte(`name: Bug report
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for reporting!
+  - type: textarea
+    id: description
+  - type: input
+    id: version
+`)
+	got, err := issueFormFieldIDs(form)
+	if err != nil {
+		t.Fatalf("issueFormFieldIDs() error = %v", err)
+	}
+	if want := []string{"description", "version"}; !slices.Equal(got, want) {
+		t.Errorf("issueFormFieldIDs() = %v, want %v", got, want)
+	}
+}
+
+func TestIsIssueForm(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"bug_report.yml", true},
+		{"feature.yaml", true},
+		{"config.yml", false},
+		{"bug_report.md", false},
+	}
+
+	for _, tt := range tests {
+		if got := isIssueForm(tt.name); got != tt.want {
+			t.Errorf("isIssueForm(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gobwas/glob"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// TopicsCheck validates that required topics are set and that every topic is allowed
+type TopicsCheck struct {
+	client  *github.Client
+	config  *config.TopicsConfig
+	verbose bool
+}
+
+// NewTopicsCheck creates a new topics check
+func NewTopicsCheck(client *github.Client, cfg *config.TopicsConfig, verbose bool) *TopicsCheck {
+	return &TopicsCheck{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Type returns the check type
+func (c *TopicsCheck) Type() CheckType {
+	return CheckTypeTopics
+}
+
+// Name returns the check name
+func (c *TopicsCheck) Name() string {
+	return "topics"
+}
+
+// Run executes the topics check
+func (c *TopicsCheck) Run(ctx context.Context) ([]Issue, error) {
+	if c.config == nil || (len(c.config.RequiredTopics) == 0 && len(c.config.AllowedTopics) == 0) {
+		return nil, nil
+	}
+
+	topics, err := c.client.GetTopics()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch topics: %w", err)
+	}
+
+	var issues []Issue
+
+	for _, required := range c.config.RequiredTopics {
+		if !slices.Contains(topics, strings.ToLower(required)) {
+			issues = append(issues, Issue{
+				Type:    c.Type(),
+				Name:    c.Name(),
+				Message: fmt.Sprintf("Topic '%s' is missing", required),
+				Fixable: true,
+				Data:    map[string]string{DataKeyTopic: strings.ToLower(required), DataKeyTopicAction: TopicActionAdd},
+			})
+		}
+	}
+
+	if len(c.config.AllowedTopics) > 0 {
+		disallowed, err := disallowedTopics(topics, c.config.AllowedTopics, c.config.RequiredTopics)
+		if err != nil {
+			return nil, err
+		}
+		for _, topic := range disallowed {
+			issues = append(issues, Issue{
+				Type:        c.Type(),
+				Name:        c.Name(),
+				Message:     fmt.Sprintf("Topic '%s' is not in allowed_topics", topic),
+				Fixable:     true,
+				Destructive: true,
+				Data:        map[string]string{DataKeyTopic: topic, DataKeyTopicAction: TopicActionRemove},
+			})
+		}
+	}
+
+	return issues, nil
+}
+
+// disallowedTopics returns the topics that match no allowed pattern and are not required.
+// Topics are always lowercase on GitHub, so patterns are matched case-insensitively.
+func disallowedTopics(topics, allowed, required []string) ([]string, error) {
+	globs := make([]glob.Glob, 0, len(allowed))
+	for _, pattern := range allowed {
+		g, err := glob.Compile(strings.ToLower(pattern))
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed_topics pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+
+	var disallowed []string
+	for _, topic := range topics {
+		if slices.ContainsFunc(required, func(r string) bool { return strings.EqualFold(r, topic) }) {
+			continue
+		}
+		if !slices.ContainsFunc(globs, func(g glob.Glob) bool { return g.Match(topic) }) {
+			disallowed = append(disallowed, topic)
+		}
+	}
+	return disallowed, nil
+}
@@ -0,0 +1,34 @@
+package checks
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDisallowedTopics(t *testing.T) {
+	tests := []struct {
+		name     string
+		topics   []string
+		allowed  []string
+		required []string
+		want     []string
+	}{
+		{"all allowed", []string{"go", "cli"}, []string{"go", "cli"}, nil, nil},
+		{"ad-hoc topic", []string{"go", "my-cool-project"}, []string{"go"}, nil, []string{"my-cool-project"}},
+		{"glob pattern", []string{"lang-go", "team-platform"}, []string{"lang-*"}, nil, []string{"team-platform"}},
+		{"required is allowed", []string{"go", "internal"}, []string{"go"}, []string{"Internal"}, nil},
+		{"uppercase pattern", []string{"golang"}, []string{"GoLang"}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := disallowedTopics(tt.topics, tt.allowed, tt.required)
+			if err != nil {
+				t.Fatalf("disallowedTopics() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("disallowedTopics() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// baseFetchTimeout bounds the request for a base config served from a non-GitHub URL
+const baseFetchTimeout = 30 * time.Second
+
+// maxBaseSize limits the size of a base config served from a non-GitHub URL
+const maxBaseSize = 1 << 20
+
+// applyBase loads the base config declared by the repo config (or, failing that, the
+// owner config) and merges the result over it, so that precedence is base < owner < repo
+func (l *Loader) applyBase(result *LoadedConfig) error {
+	reference := ""
+	if result.OwnerConfig != nil {
+		reference = result.OwnerConfig.Base
+	}
+	if result.RepoConfig != nil && result.RepoConfig.Base != "" {
+		reference = result.RepoConfig.Base
+	}
+	if reference == "" {
+		return nil
+	}
+
+	base, err := l.loadBase(reference)
+	if err != nil {
+		return fmt.Errorf("error loading base config %s: %w", reference, err)
+	}
+
+	result.BaseConfig = base
+	result.BaseSource = reference
+	result.Config = MergeConfigs(MergeConfigs(base, result.OwnerConfig), result.RepoConfig)
+	return nil
+}
+
+// loadBase fetches and parses a base config, at most once per loader
+func (l *Loader) loadBase(reference string) (*Config, error) {
+	if base, ok := l.bases[reference]; ok {
+		return base, nil
+	}
+
+	content, err := l.fetchBase(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := parseConfigBytes(content)
+	if err != nil {
+		return nil, err
+	}
+	// Bases are not recursive
+	if base.Base != "" {
+		return nil, fmt.Errorf("a base config cannot declare its own base (found %q)", base.Base)
+	}
+
+	l.bases[reference] = base
+	return base, nil
+}
+
+// fetchBase reads a base config from a reference file (local, owner/repo/path[@ref], or
+// a GitHub URL fetched through the authenticated API) or, for other hosts, over HTTPS
+func (l *Loader) fetchBase(reference string) ([]byte, error) {
+	if !github.IsURLReference(reference) || github.IsGitHubURLReference(reference) {
+		return github.ResolveReferenceFile(reference, l.github)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), baseFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reference, nil)
+	if err != nil {
+		return nil, err
+	}
+	if req.URL.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported base URL scheme %q (expected https)", req.URL.Scheme)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching base config: %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxBaseSize))
+}
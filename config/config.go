@@ -3,15 +3,47 @@ package config
 
 // Config represents the complete repolint configuration
 type Config struct {
+	// Base is a shared config (a reference file or URL) that this config overrides
+	Base   string       `yaml:"base,omitempty"`
 	Checks ChecksConfig `yaml:"checks" validate:"required"`
 }
 
 // ChecksConfig contains all check configurations
 type ChecksConfig struct {
-	Settings *SettingsConfig `yaml:"settings,omitempty"`
-	Actions  *ActionsConfig  `yaml:"actions,omitempty"`
-	Rulesets []RulesetConfig `yaml:"rulesets,omitempty"`
-	Files    []FileConfig    `yaml:"files,omitempty"`
+	Settings   *SettingsConfig   `yaml:"settings,omitempty"`
+	Actions    *ActionsConfig    `yaml:"actions,omitempty"`
+	Rulesets   []RulesetConfig   `yaml:"rulesets,omitempty"`
+	RulesetSet *RulesetSetConfig `yaml:"ruleset_set,omitempty"`
+	Files      []FileConfig      `yaml:"files,omitempty"`
+	Autolinks  []AutolinkConfig  `yaml:"autolinks,omitempty"`
+	Branches   *BranchesConfig   `yaml:"branches,omitempty"`
+	Funding    *FundingConfig    `yaml:"funding,omitempty"`
+	Dependabot *DependabotConfig `yaml:"dependabot,omitempty"`
+	// Organization is checked once per owner by `gh repolint org`, not per repository
+	Organization *OrganizationConfig `yaml:"organization,omitempty"`
+	// HelpURLs overrides the documentation link reported for each check type (e.g. "files")
+	HelpURLs map[string]string `yaml:"help_urls,omitempty"`
+	Labels   []LabelConfig     `yaml:"labels,omitempty"`
+	Topics   *TopicsConfig     `yaml:"topics,omitempty"`
+	// Languages validates the languages detected by GitHub
+	Languages *LanguagesConfig `yaml:"languages,omitempty"`
+	// BranchRules validates the rules protecting the default branch without a reference ruleset
+	BranchRules *BranchRulesConfig `yaml:"branch_rules,omitempty"`
+	// TemplateStructure validates the contents of issue forms and the pull request template
+	TemplateStructure *TemplateStructureConfig `yaml:"template_structure,omitempty"`
+	// Readme validates the sections of the repository README
+	Readme *ReadmeConfig `yaml:"readme,omitempty"`
+	// CheckRuns validates that required check runs report on the default branch
+	CheckRuns *CheckRunsConfig `yaml:"check_runs,omitempty"`
+	// Custom checks run external commands, and only with --allow-exec
+	Custom []CustomCheckConfig `yaml:"custom,omitempty"`
+}
+
+// CustomCheckConfig defines an external command that implements an organization-specific check
+type CustomCheckConfig struct {
+	Name string `yaml:"name"`
+	// Exec is the command and its arguments; it is run directly, not through a shell
+	Exec []string `yaml:"exec"`
 }
 
 // SettingsConfig defines repository settings to validate
@@ -20,11 +52,38 @@ type SettingsConfig struct {
 	Wiki                      *bool                     `yaml:"wiki,omitempty"`
 	Projects                  *bool                     `yaml:"projects,omitempty"`
 	Discussions               *bool                     `yaml:"discussions,omitempty"`
+	Downloads                 *bool                     `yaml:"downloads,omitempty"`
+	AllowForking              *bool                     `yaml:"allow_forking,omitempty"`
+	WebCommitSignoffRequired  *bool                     `yaml:"web_commit_signoff_required,omitempty"`
 	AllowActionsToApprovePRs  *bool                     `yaml:"allow_actions_to_approve_prs,omitempty"`
 	PullRequestCreationPolicy string                    `yaml:"pull_request_creation_policy,omitempty"`
 	Merge                     *MergeConfig              `yaml:"merge,omitempty"`
+	MergeQueue                *MergeQueueConfig         `yaml:"merge_queue,omitempty"`
 	DefaultBranch             string                    `yaml:"default_branch,omitempty"`
 	Dependabot                *DependabotSettingsConfig `yaml:"dependabot,omitempty"`
+	// HomepageAllowedHosts lists glob patterns (e.g. "*.example.com") the homepage URL's host must match
+	HomepageAllowedHosts []string `yaml:"homepage_allowed_hosts,omitempty"`
+	// DiscussionCategories lists discussion categories (e.g. "Q&A") that must exist
+	DiscussionCategories []string `yaml:"discussion_categories,omitempty"`
+	// MaxSizeKB flags repositories larger than this size (as reported by the API, in kilobytes)
+	MaxSizeKB *int `yaml:"max_size_kb,omitempty"`
+	// AdvancedSecurity enables/disables GitHub Advanced Security on private and internal
+	// repositories; public repositories have its features without it
+	AdvancedSecurity *bool `yaml:"advanced_security,omitempty"`
+	// SecretScanningNonProvider enables/disables secret scanning for non-provider
+	// patterns, such as private keys and connection strings
+	SecretScanningNonProvider *bool `yaml:"secret_scanning_non_provider_patterns,omitempty"`
+	// MergeTiers checks the merge settings expected for the repository's tier, a custom property
+	MergeTiers *MergeTiersConfig `yaml:"merge_tiers,omitempty"`
+}
+
+// MergeTiersConfig looks up the expected merge settings by the value of a repository
+// custom property, from a matrix published in a reference file
+type MergeTiersConfig struct {
+	// Property is the custom property holding the repository's tier (e.g. "tier")
+	Property string `yaml:"property" validate:"required"`
+	// Reference is a YAML file mapping each tier to merge settings, in the form of settings.merge
+	Reference string `yaml:"reference" validate:"required"`
 }
 
 // DependabotSettingsConfig defines Dependabot-related settings to validate
@@ -33,6 +92,8 @@ type DependabotSettingsConfig struct {
 	Alerts *bool `yaml:"alerts,omitempty"`
 	// SecurityUpdates enables/disables Dependabot security updates (automated security fixes)
 	SecurityUpdates *bool `yaml:"security_updates,omitempty"`
+	// DependencyGraph enables/disables the dependency graph, which Dependabot alerts require
+	DependencyGraph *bool `yaml:"dependency_graph,omitempty"`
 }
 
 // MergeConfig defines merge-related settings
@@ -43,35 +104,292 @@ type MergeConfig struct {
 	AllowAutoMerge                           *bool `yaml:"allow_auto_merge,omitempty"`
 	DeleteBranchOnMerge                      *bool `yaml:"delete_branch_on_merge,omitempty"`
 	AlwaysSuggestUpdatingPullRequestBranches *bool `yaml:"always_suggest_updating_pull_request_branches,omitempty"`
+	// SquashMergeCommitMessage is the default squash commit message: "PR_BODY", "COMMIT_MESSAGES" or "BLANK"
+	SquashMergeCommitMessage string `yaml:"squash_merge_commit_message,omitempty"`
+}
+
+// MergeQueueConfig defines the expected merge queue parameters of the default branch.
+// Parameters are only compared when a merge queue is enabled.
+type MergeQueueConfig struct {
+	// MergeMethod is "MERGE", "SQUASH" or "REBASE"
+	MergeMethod string `yaml:"merge_method,omitempty"`
+	// GroupingStrategy is "ALLGREEN" or "HEADGREEN"
+	GroupingStrategy             string `yaml:"grouping_strategy,omitempty"`
+	MaxEntriesToBuild            *int   `yaml:"max_entries_to_build,omitempty"`
+	MaxEntriesToMerge            *int   `yaml:"max_entries_to_merge,omitempty"`
+	MinEntriesToMerge            *int   `yaml:"min_entries_to_merge,omitempty"`
+	MinEntriesToMergeWaitMinutes *int   `yaml:"min_entries_to_merge_wait_minutes,omitempty"`
+	CheckResponseTimeoutMinutes  *int   `yaml:"check_response_timeout_minutes,omitempty"`
 }
 
 // ActionsConfig defines GitHub Actions workflow validation settings
 type ActionsConfig struct {
-	RequirePinnedVersions     *bool            `yaml:"require_pinned_versions,omitempty"`
-	RequiredWorkflows         []WorkflowConfig `yaml:"required_workflows,omitempty"`
-	RequireTimeout            *bool            `yaml:"require_timeout,omitempty"`
-	MaxTimeoutMinutes         *int             `yaml:"max_timeout_minutes,omitempty"`
-	RequireMinimalPermissions *bool            `yaml:"require_minimal_permissions,omitempty"`
+	RequirePinnedVersions      *bool            `yaml:"require_pinned_versions,omitempty"`
+	RequiredWorkflows          []WorkflowConfig `yaml:"required_workflows,omitempty"`
+	RequireTimeout             *bool            `yaml:"require_timeout,omitempty"`
+	MaxTimeoutMinutes          *int             `yaml:"max_timeout_minutes,omitempty"`
+	RequireMinimalPermissions  *bool            `yaml:"require_minimal_permissions,omitempty"`
+	RequireWorkflowName        *bool            `yaml:"require_workflow_name,omitempty"`
+	MaxScheduledWorkflows      *int             `yaml:"max_scheduled_workflows,omitempty"`
+	MinScheduleIntervalMinutes *int             `yaml:"min_schedule_interval_minutes,omitempty"`
+	ForbidHardcodedSecrets     *bool            `yaml:"forbid_hardcoded_secrets,omitempty"`
+	RequireScheduleDispatch    *bool            `yaml:"require_schedule_dispatch,omitempty"`
+	ForbidPullRequestSecretEnv *bool            `yaml:"forbid_pull_request_secret_env,omitempty"`
+	// DetectUnusedWritePermissions reports write-all or contents: write on jobs that only appear to read
+	DetectUnusedWritePermissions *bool `yaml:"detect_unused_write_permissions,omitempty"`
+	// RequireDependencyCache lists glob patterns of workflow file names (e.g. "build*.yml")
+	// that must cache dependencies
+	RequireDependencyCache []string `yaml:"require_dependency_cache,omitempty"`
+	// ForbidLatestRunners reports jobs that run on a floating "*-latest" runner label
+	ForbidLatestRunners *bool `yaml:"forbid_latest_runners,omitempty"`
+	// PinnedRunners lists the only runner labels jobs may use (e.g. "ubuntu-24.04")
+	PinnedRunners []string `yaml:"pinned_runners,omitempty"`
+	// CheckoutFetchDepth asserts the actions/checkout fetch-depth per workflow; the first
+	// rule whose pattern matches a workflow file name applies
+	CheckoutFetchDepth []FetchDepthConfig `yaml:"checkout_fetch_depth,omitempty"`
+	// MaxStepTimeoutMinutes flags steps whose timeout-minutes exceeds this
+	MaxStepTimeoutMinutes *int `yaml:"max_step_timeout_minutes,omitempty"`
+	// RequireStepTimeoutAboveMinutes requires timeout-minutes on every step of jobs whose
+	// timeout (360 minutes when unset) exceeds this
+	RequireStepTimeoutAboveMinutes *int `yaml:"require_step_timeout_above_minutes,omitempty"`
+	// RequireProtectedEnvironments reports jobs deploying to an environment without protection rules
+	RequireProtectedEnvironments *bool `yaml:"require_protected_environments,omitempty"`
+	// OIDCJobs lists glob patterns of job IDs (e.g. "deploy*") that must be granted
+	// id-token: write; every other job must not be
+	OIDCJobs []string `yaml:"oidc_jobs,omitempty"`
+	// RequireActiveWorkflows reports required workflows that are disabled in GitHub Actions
+	RequireActiveWorkflows *bool `yaml:"require_active_workflows,omitempty"`
+	// ValidateReusableWorkflowInputs reports `with:` inputs of reusable workflow calls that the
+	// callee does not declare, and required callee inputs that are not passed
+	ValidateReusableWorkflowInputs *bool `yaml:"validate_reusable_workflow_inputs,omitempty"`
+	// ForbidDeprecatedCommands reports steps that use the deprecated ::set-output or
+	// ::save-state workflow commands
+	ForbidDeprecatedCommands *bool `yaml:"forbid_deprecated_commands,omitempty"`
+	// RestrictPullRequestTarget reports workflows triggered by pull_request_target unless
+	// they are listed in pull_request_target_allowlist
+	RestrictPullRequestTarget *bool `yaml:"restrict_pull_request_target,omitempty"`
+	// PullRequestTargetAllowlist lists the workflows that may use pull_request_target
+	PullRequestTargetAllowlist []PullRequestTargetConfig `yaml:"pull_request_target_allowlist,omitempty"`
+}
+
+// PullRequestTargetConfig allows a workflow to be triggered by pull_request_target
+type PullRequestTargetConfig struct {
+	// Workflow is the workflow file name (e.g. "triage.yml") or path
+	Workflow string `yaml:"workflow" validate:"required"`
+	// Justification is a reference to a document explaining why the workflow needs the trigger
+	Justification string `yaml:"justification" validate:"required"`
+}
+
+// FetchDepthConfig requires a fetch-depth for actions/checkout steps in matching workflows
+type FetchDepthConfig struct {
+	// Workflows is a glob pattern of workflow file names (e.g. "release*.yml")
+	Workflows string `yaml:"workflows" validate:"required"`
+	// FetchDepth is the required fetch-depth: 0 for full history, 1 (the default) for a shallow clone
+	FetchDepth int `yaml:"fetch_depth"`
 }
 
 // WorkflowConfig defines a required workflow file
+// RequiredJobs asserts job IDs exist without requiring the whole file to match a reference
 type WorkflowConfig struct {
-	Path      string `yaml:"path" validate:"required"`
-	Reference string `yaml:"reference,omitempty"`
+	Path         string   `yaml:"path" validate:"required"`
+	Reference    string   `yaml:"reference,omitempty"`
+	RequiredJobs []string `yaml:"required_jobs,omitempty"`
+	// IgnorePinnedVersions compares the reference without the commit SHAs actions are
+	// pinned to, so a workflow pinned to an older commit of the same action still matches
+	IgnorePinnedVersions *bool `yaml:"ignore_pinned_versions,omitempty"`
 }
 
 // RulesetConfig defines a repository ruleset configuration
 // The reference field points to a JSON file exported via `gh ruleset export`
 // Format: owner/repo/path/to/ruleset.json
+// Inline assertions (e.g. enforcement) can be used with or without a reference
 type RulesetConfig struct {
-	Name      string `yaml:"name" validate:"required"`
+	Name          string               `yaml:"name" validate:"required"`
+	Reference     string               `yaml:"reference,omitempty"`
+	Enforcement   string               `yaml:"enforcement,omitempty"`
+	TagProtection *TagProtectionConfig `yaml:"tag_protection,omitempty"`
+	// AllowedBypassActors lists the only actors permitted to bypass the ruleset; an empty
+	// list allows none. Unset means bypass actors are not checked.
+	AllowedBypassActors []BypassActorConfig `yaml:"allowed_bypass_actors,omitempty"`
+	// RequireBypassMode is the bypass mode every live bypass actor must use; only
+	// "pull_request" is supported, so that bypasses happen through a reviewed PR
+	RequireBypassMode string `yaml:"require_bypass_mode,omitempty"`
+	// BypassExpiries is a reference to a YAML registry of temporary bypass grants, each an
+	// actor_type, optional actor_id and an expires date (YYYY-MM-DD, the last valid day)
+	BypassExpiries string `yaml:"bypass_expiries,omitempty"`
+}
+
+// RulesetSetConfig defines the complete set of rulesets a repository must have: each
+// reference ruleset must exist and match, and no other repository ruleset may exist
+type RulesetSetConfig struct {
+	// Reference is a local directory, or a remote directory (owner/repo/path[@ref]), of
+	// ruleset JSON files exported via `gh ruleset export`; rulesets are matched by name
 	Reference string `yaml:"reference" validate:"required"`
 }
 
+// BranchRulesConfig declares the rules that must protect the default branch, from any
+// active ruleset (including organization rulesets)
+type BranchRulesConfig struct {
+	// RulesetName is the repository ruleset --fix creates or updates (default: "default-branch")
+	RulesetName        string `yaml:"ruleset_name,omitempty"`
+	RequirePullRequest *bool  `yaml:"require_pull_request,omitempty"`
+	// RequiredApprovals is the minimum number of approving reviews; it implies require_pull_request
+	RequiredApprovals    *int  `yaml:"required_approvals,omitempty"`
+	RequireLinearHistory *bool `yaml:"require_linear_history,omitempty"`
+	// RequireStatusChecks lists status check contexts (e.g. "ci / build") that must pass
+	RequireStatusChecks []string `yaml:"require_status_checks,omitempty"`
+}
+
+// BypassActorConfig identifies a ruleset bypass actor
+type BypassActorConfig struct {
+	// ActorType is "Integration", "OrganizationAdmin", "RepositoryRole", "Team" or "DeployKey"
+	ActorType string `yaml:"actor_type" validate:"required"`
+	// ActorID is the app, role or team ID; when unset any actor of ActorType is allowed
+	ActorID *int `yaml:"actor_id,omitempty"`
+}
+
+// TagProtectionConfig asserts that a tag ruleset prevents matching tags from being changed
+type TagProtectionConfig struct {
+	// Pattern is the tag name pattern that must be covered (e.g. "v*")
+	Pattern string `yaml:"pattern" validate:"required"`
+	// Rules lists the required restriction rule types (defaults to update and deletion)
+	Rules []string `yaml:"rules,omitempty"`
+}
+
 // FileConfig defines a file that should match a reference
 // The reference field points to a file that the local file should match
-// Format: owner/repo/path/to/file or local path
+// Format: owner/repo/path/to/file[@ref] or local path
 type FileConfig struct {
 	Name      string `yaml:"name" validate:"required"`
 	Reference string `yaml:"reference" validate:"required"`
+	// DetectDrift compares a mismatched file against the reference's default branch as well
+	// as its pinned ref, to report which side changed. Requires a pinned (@ref) remote reference.
+	DetectDrift *bool `yaml:"detect_drift,omitempty"`
+	// LanguageReferences selects the reference by the repository's primary language
+	// (as reported by GitHub, e.g. "Go"); Reference is the fallback for other languages
+	LanguageReferences map[string]string `yaml:"language_references,omitempty"`
+	// IgnorePatterns are regular expressions; lines matching any of them are removed from
+	// both the reference and the actual file before comparing (e.g. a per-repo version stamp)
+	IgnorePatterns []string `yaml:"ignore_patterns,omitempty"`
+}
+
+// AutolinkConfig defines an autolink reference that must exist on the repository
+// The url_template must contain <num> where the reference number is substituted
+type AutolinkConfig struct {
+	KeyPrefix      string `yaml:"key_prefix" validate:"required"`
+	URLTemplate    string `yaml:"url_template" validate:"required"`
+	IsAlphanumeric *bool  `yaml:"is_alphanumeric,omitempty"`
+}
+
+// LabelConfig defines an issue/PR label that must exist on the repository
+// Color and description are only validated when set; color is a 6-digit hex code without "#"
+type LabelConfig struct {
+	Name        string `yaml:"name" validate:"required"`
+	Color       string `yaml:"color,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// BranchesConfig defines branch hygiene validation settings
+type BranchesConfig struct {
+	// StaleAfterDays reports non-default, unprotected branches whose last commit is older than this many days
+	StaleAfterDays *int `yaml:"stale_after_days,omitempty"`
+	// ForbiddenBranches lists glob patterns of legacy branch names that must not exist (e.g. "gh-pages", "develop")
+	ForbiddenBranches []string `yaml:"forbidden_branches,omitempty"`
+}
+
+// TopicsConfig defines the topics a repository must and may have
+type TopicsConfig struct {
+	// RequiredTopics lists topics that must be set
+	RequiredTopics []string `yaml:"required_topics,omitempty"`
+	// AllowedTopics lists glob patterns (e.g. "lang-*") of the only topics that may be set;
+	// required topics are always allowed
+	AllowedTopics []string `yaml:"allowed_topics,omitempty"`
+}
+
+// LanguagesConfig defines the languages a repository is expected to be written in,
+// as detected by GitHub's linguist
+type LanguagesConfig struct {
+	// Primary is the expected language with the most bytes of code (e.g. "Go")
+	Primary string `yaml:"primary,omitempty"`
+	// Forbidden lists languages that must not appear in the repository
+	Forbidden []string `yaml:"forbidden,omitempty"`
+	// ForbiddenThresholdPercent ignores forbidden languages at or below this share of
+	// the code (default: 0, any amount is reported)
+	ForbiddenThresholdPercent *int `yaml:"forbidden_threshold_percent,omitempty"`
+}
+
+// TemplateStructureConfig defines the structure that issue forms and the pull request
+// template must have, beyond merely existing
+type TemplateStructureConfig struct {
+	// PullRequestHeadings lists markdown headings the pull request template must contain
+	PullRequestHeadings []string `yaml:"pull_request_headings,omitempty"`
+	// IssueForms lists the fields that issue forms in .github/ISSUE_TEMPLATE must define
+	IssueForms []IssueFormConfig `yaml:"issue_forms,omitempty"`
+}
+
+// ReadmeConfig defines the sections the repository README must contain
+type ReadmeConfig struct {
+	// RequiredSections lists headings (e.g. "Getting Started" or "## Getting Started") the
+	// README must contain, at any level
+	RequiredSections []string `yaml:"required_sections,omitempty"`
+}
+
+// CheckRunsConfig defines the check runs that must report on the default branch
+type CheckRunsConfig struct {
+	// Required lists check runs, typically from external apps rather than workflows, that
+	// must have run on the latest commit of the default branch
+	Required []CheckRunConfig `yaml:"required,omitempty"`
+}
+
+// CheckRunConfig identifies a required check run
+type CheckRunConfig struct {
+	// Name is the check run name (e.g. "SonarCloud Code Analysis")
+	Name string `yaml:"name" validate:"required"`
+	// App is the slug of the GitHub App that must report it (e.g. "sonarqubecloud"); any app if empty
+	App string `yaml:"app,omitempty"`
+}
+
+// IssueFormConfig defines the field IDs required in the issue forms matching a pattern
+type IssueFormConfig struct {
+	// Forms is a glob pattern of form file names (default: "*", every form)
+	Forms          string   `yaml:"forms,omitempty"`
+	RequiredFields []string `yaml:"required_fields"`
+}
+
+// OrganizationConfig defines organization-level settings to validate
+type OrganizationConfig struct {
+	// DefaultRepositoryPermission is the base permission of members: "read", "write", "admin" or "none"
+	DefaultRepositoryPermission  string `yaml:"default_repository_permission,omitempty"`
+	MembersCanCreateRepositories *bool  `yaml:"members_can_create_repositories,omitempty"`
+	// DefaultWorkflowPermissions is the GITHUB_TOKEN permission granted to workflows by
+	// default: "read" (contents and packages only) or "write"
+	DefaultWorkflowPermissions string `yaml:"default_workflow_permissions,omitempty"`
+}
+
+// DependabotConfig defines rules for the update blocks of .github/dependabot.yml
+type DependabotConfig struct {
+	// RequireOpenPullRequestsLimit requires every update block to set open-pull-requests-limit
+	RequireOpenPullRequestsLimit *bool `yaml:"require_open_pull_requests_limit,omitempty"`
+	// MaxOpenPullRequestsLimit is the highest open-pull-requests-limit an update block may set
+	MaxOpenPullRequestsLimit *int `yaml:"max_open_pull_requests_limit,omitempty"`
+	// TargetBranch asserts the branch that update blocks open pull requests against
+	TargetBranch *DependabotTargetBranchConfig `yaml:"target_branch,omitempty"`
+	// ScheduleIntervals maps a package ecosystem (e.g. "npm") to the schedule.interval
+	// ("daily", "weekly" or "monthly") its update blocks must use
+	ScheduleIntervals map[string]string `yaml:"schedule_intervals,omitempty"`
+}
+
+// DependabotTargetBranchConfig defines the branch Dependabot update blocks must target
+type DependabotTargetBranchConfig struct {
+	// Branch is the expected target-branch; empty means the repository's default branch
+	Branch string `yaml:"branch,omitempty"`
+	// Ecosystems limits the assertion to these package ecosystems (e.g. "gomod"); empty means all
+	Ecosystems []string `yaml:"ecosystems,omitempty"`
+}
+
+// FundingConfig defines entries that .github/FUNDING.yml must contain
+// Only applies to public repositories
+type FundingConfig struct {
+	GitHub []string `yaml:"github,omitempty"`
+	Custom []string `yaml:"custom,omitempty"`
 }
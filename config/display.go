@@ -3,7 +3,9 @@ package config
 import (
 	"fmt"
 	"io"
+	"maps"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -37,6 +39,9 @@ func DisplayConfig(w io.Writer, loaded *LoadedConfig, useColor bool, validator R
 	} else {
 		_, _ = fmt.Fprintln(w, "Legend: [repo] repo-level | [owner] owner-level")
 	}
+	if loaded.BaseSource != "" {
+		_, _ = fmt.Fprintf(w, "Base: %s (unannotated values)\n", loaded.BaseSource)
+	}
 	_, _ = fmt.Fprintln(w, "")
 
 	displayChecks(w, loaded, useColor, 0, validator, result)
@@ -65,9 +70,81 @@ func displayChecks(w io.Writer, loaded *LoadedConfig, useColor bool, indent int,
 		displayRulesetsConfig(w, loaded, useColor, indent+2, validator, result)
 	}
 
+	if cfg.Checks.RulesetSet != nil {
+		displayRulesetSetConfig(w, loaded, useColor, indent+2)
+	}
+
 	if len(cfg.Checks.Files) > 0 {
 		displayFilesConfig(w, loaded, useColor, indent+2, validator, result)
 	}
+
+	if len(cfg.Checks.Autolinks) > 0 {
+		displayAutolinksConfig(w, loaded, useColor, indent+2)
+	}
+
+	if len(cfg.Checks.Labels) > 0 {
+		displayLabelsConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.Branches != nil {
+		displayBranchesConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.Topics != nil {
+		displayTopicsConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.Languages != nil {
+		displayLanguagesConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.BranchRules != nil {
+		displayBranchRulesConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.TemplateStructure != nil {
+		displayTemplateStructureConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.Readme != nil {
+		displayReadmeConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.CheckRuns != nil {
+		displayCheckRunsConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.Funding != nil {
+		displayFundingConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.Dependabot != nil {
+		displayDependabotConfig(w, loaded, useColor, indent+2)
+	}
+
+	if len(cfg.Checks.Custom) > 0 {
+		displayCustomConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.Checks.Organization != nil {
+		displayOrganizationConfig(w, loaded, useColor, indent+2)
+	}
+
+	if len(cfg.Checks.HelpURLs) > 0 {
+		displayHelpURLs(w, loaded, useColor, indent+2)
+	}
+}
+
+func displayHelpURLs(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "help_urls:")
+
+	// Help URLs are merged per key - repo keys override owner keys
+	helpURLs := loaded.Config.Checks.HelpURLs
+	for _, checkType := range slices.Sorted(maps.Keys(helpURLs)) {
+		source := keySource(loaded, "Checks.HelpURLs", checkType)
+		displayStringField(w, checkType, helpURLs[checkType], source, useColor, indent+2)
+	}
 }
 
 func displaySettingsConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
@@ -82,28 +159,54 @@ func displaySettingsConfig(w io.Writer, loaded *LoadedConfig, useColor bool, ind
 	displayBoolField(w, "wiki", cfg.Wiki, getBoolSource(repo, owner, "Wiki"), useColor, indent+2)
 	displayBoolField(w, "projects", cfg.Projects, getBoolSource(repo, owner, "Projects"), useColor, indent+2)
 	displayBoolField(w, "discussions", cfg.Discussions, getBoolSource(repo, owner, "Discussions"), useColor, indent+2)
+	displayBoolField(w, "downloads", cfg.Downloads, getBoolSource(repo, owner, "Downloads"), useColor, indent+2)
+	displayBoolField(w, "allow_forking", cfg.AllowForking, getBoolSource(repo, owner, "AllowForking"), useColor, indent+2)
+	displayBoolField(w, "web_commit_signoff_required", cfg.WebCommitSignoffRequired, getBoolSource(repo, owner, "WebCommitSignoffRequired"), useColor, indent+2)
 	displayBoolField(w, "allow_actions_to_approve_prs", cfg.AllowActionsToApprovePRs, getBoolSource(repo, owner, "AllowActionsToApprovePRs"), useColor, indent+2)
+	displayBoolField(w, "advanced_security", cfg.AdvancedSecurity, getBoolSource(repo, owner, "AdvancedSecurity"), useColor, indent+2)
+	displayBoolField(w, "secret_scanning_non_provider_patterns", cfg.SecretScanningNonProvider, getBoolSource(repo, owner, "SecretScanningNonProvider"), useColor, indent+2)
 
 	if cfg.PullRequestCreationPolicy != "" {
-		source := SourceOwner
-		if repo != nil && repo.PullRequestCreationPolicy != "" {
-			source = SourceRepo
-		}
+		source := fieldSource(loaded, "Checks.Settings.PullRequestCreationPolicy")
 		displayStringField(w, "pull_request_creation_policy", cfg.PullRequestCreationPolicy, source, useColor, indent+2)
 	}
 
 	if cfg.DefaultBranch != "" {
-		source := SourceOwner
-		if repo != nil && repo.DefaultBranch != "" {
-			source = SourceRepo
-		}
+		source := fieldSource(loaded, "Checks.Settings.DefaultBranch")
 		displayStringField(w, "default_branch", cfg.DefaultBranch, source, useColor, indent+2)
 	}
 
+	if len(cfg.HomepageAllowedHosts) > 0 {
+		source := fieldSource(loaded, "Checks.Settings.HomepageAllowedHosts")
+		displayStringListField(w, "homepage_allowed_hosts", cfg.HomepageAllowedHosts, source, useColor, indent+2)
+	}
+
+	if len(cfg.DiscussionCategories) > 0 {
+		source := fieldSource(loaded, "Checks.Settings.DiscussionCategories")
+		displayStringListField(w, "discussion_categories", cfg.DiscussionCategories, source, useColor, indent+2)
+	}
+
+	if cfg.MaxSizeKB != nil {
+		source := fieldSource(loaded, "Checks.Settings.MaxSizeKB")
+		displayIntField(w, "max_size_kb", *cfg.MaxSizeKB, source, useColor, indent+2)
+	}
+
 	if cfg.Merge != nil {
 		displayMergeConfig(w, loaded, useColor, indent+2)
 	}
 
+	if cfg.MergeQueue != nil {
+		displayMergeQueueConfig(w, loaded, useColor, indent+2)
+	}
+
+	if cfg.MergeTiers != nil {
+		source := fieldSource(loaded, "Checks.Settings.MergeTiers")
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "merge_tiers:")
+		displayStringField(w, "property", cfg.MergeTiers.Property, source, useColor, indent+4)
+		displayStringField(w, "reference", cfg.MergeTiers.Reference, source, useColor, indent+4)
+	}
+
 	if cfg.Dependabot != nil {
 		displayDependabotSettingsConfig(w, loaded, useColor, indent+2)
 	}
@@ -128,6 +231,51 @@ func displayMergeConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent
 	displayBoolField(w, "allow_auto_merge", cfg.AllowAutoMerge, getMergeBoolSource(repoMerge, ownerMerge, "AllowAutoMerge"), useColor, indent+2)
 	displayBoolField(w, "delete_branch_on_merge", cfg.DeleteBranchOnMerge, getMergeBoolSource(repoMerge, ownerMerge, "DeleteBranchOnMerge"), useColor, indent+2)
 	displayBoolField(w, "always_suggest_updating_pull_request_branches", cfg.AlwaysSuggestUpdatingPullRequestBranches, getMergeBoolSource(repoMerge, ownerMerge, "AlwaysSuggestUpdatingPullRequestBranches"), useColor, indent+2)
+
+	if cfg.SquashMergeCommitMessage != "" {
+		source := fieldSource(loaded, "Checks.Settings.Merge.SquashMergeCommitMessage")
+		displayStringField(w, "squash_merge_commit_message", cfg.SquashMergeCommitMessage, source, useColor, indent+2)
+	}
+}
+
+func displayMergeQueueConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "merge_queue:")
+
+	cfg := loaded.Config.Checks.Settings.MergeQueue
+	var repoQueue, ownerQueue *MergeQueueConfig
+	if loaded.RepoConfig != nil && loaded.RepoConfig.Checks.Settings != nil {
+		repoQueue = loaded.RepoConfig.Checks.Settings.MergeQueue
+	}
+	if loaded.OwnerConfig != nil && loaded.OwnerConfig.Checks.Settings != nil {
+		ownerQueue = loaded.OwnerConfig.Checks.Settings.MergeQueue
+	}
+
+	if cfg.MergeMethod != "" {
+		source := fieldSource(loaded, "Checks.Settings.MergeQueue.MergeMethod")
+		displayStringField(w, "merge_method", cfg.MergeMethod, source, useColor, indent+2)
+	}
+	if cfg.GroupingStrategy != "" {
+		source := fieldSource(loaded, "Checks.Settings.MergeQueue.GroupingStrategy")
+		displayStringField(w, "grouping_strategy", cfg.GroupingStrategy, source, useColor, indent+2)
+	}
+
+	intFields := []struct {
+		name  string
+		field string
+		value *int
+	}{
+		{"max_entries_to_build", "MaxEntriesToBuild", cfg.MaxEntriesToBuild},
+		{"max_entries_to_merge", "MaxEntriesToMerge", cfg.MaxEntriesToMerge},
+		{"min_entries_to_merge", "MinEntriesToMerge", cfg.MinEntriesToMerge},
+		{"min_entries_to_merge_wait_minutes", "MinEntriesToMergeWaitMinutes", cfg.MinEntriesToMergeWaitMinutes},
+		{"check_response_timeout_minutes", "CheckResponseTimeoutMinutes", cfg.CheckResponseTimeoutMinutes},
+	}
+	for _, f := range intFields {
+		if f.value != nil {
+			displayIntField(w, f.name, *f.value, getMergeQueueSource(repoQueue, ownerQueue, f.field), useColor, indent+2)
+		}
+	}
 }
 
 func displayDependabotSettingsConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
@@ -145,6 +293,7 @@ func displayDependabotSettingsConfig(w io.Writer, loaded *LoadedConfig, useColor
 
 	displayBoolField(w, "alerts", cfg.Alerts, getDependabotBoolSource(repoDependabot, ownerDependabot, "Alerts"), useColor, indent+2)
 	displayBoolField(w, "security_updates", cfg.SecurityUpdates, getDependabotBoolSource(repoDependabot, ownerDependabot, "SecurityUpdates"), useColor, indent+2)
+	displayBoolField(w, "dependency_graph", cfg.DependencyGraph, getDependabotBoolSource(repoDependabot, ownerDependabot, "DependencyGraph"), useColor, indent+2)
 }
 
 func displayActionsConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
@@ -158,20 +307,82 @@ func displayActionsConfig(w io.Writer, loaded *LoadedConfig, useColor bool, inde
 	displayBoolField(w, "require_pinned_versions", cfg.RequirePinnedVersions, getActionsBoolSource(repo, owner, "RequirePinnedVersions"), useColor, indent+2)
 	displayBoolField(w, "require_timeout", cfg.RequireTimeout, getActionsBoolSource(repo, owner, "RequireTimeout"), useColor, indent+2)
 	displayBoolField(w, "require_minimal_permissions", cfg.RequireMinimalPermissions, getActionsBoolSource(repo, owner, "RequireMinimalPermissions"), useColor, indent+2)
+	displayBoolField(w, "require_workflow_name", cfg.RequireWorkflowName, getActionsBoolSource(repo, owner, "RequireWorkflowName"), useColor, indent+2)
+	displayBoolField(w, "forbid_hardcoded_secrets", cfg.ForbidHardcodedSecrets, getActionsBoolSource(repo, owner, "ForbidHardcodedSecrets"), useColor, indent+2)
+	displayBoolField(w, "forbid_pull_request_secret_env", cfg.ForbidPullRequestSecretEnv, getActionsBoolSource(repo, owner, "ForbidPullRequestSecretEnv"), useColor, indent+2)
+	displayBoolField(w, "detect_unused_write_permissions", cfg.DetectUnusedWritePermissions, getActionsBoolSource(repo, owner, "DetectUnusedWritePermissions"), useColor, indent+2)
+	displayBoolField(w, "require_schedule_dispatch", cfg.RequireScheduleDispatch, getActionsBoolSource(repo, owner, "RequireScheduleDispatch"), useColor, indent+2)
+	displayBoolField(w, "forbid_latest_runners", cfg.ForbidLatestRunners, getActionsBoolSource(repo, owner, "ForbidLatestRunners"), useColor, indent+2)
+	displayBoolField(w, "require_protected_environments", cfg.RequireProtectedEnvironments, getActionsBoolSource(repo, owner, "RequireProtectedEnvironments"), useColor, indent+2)
+	displayBoolField(w, "require_active_workflows", cfg.RequireActiveWorkflows, getActionsBoolSource(repo, owner, "RequireActiveWorkflows"), useColor, indent+2)
+	displayBoolField(w, "validate_reusable_workflow_inputs", cfg.ValidateReusableWorkflowInputs, getActionsBoolSource(repo, owner, "ValidateReusableWorkflowInputs"), useColor, indent+2)
+	displayBoolField(w, "forbid_deprecated_commands", cfg.ForbidDeprecatedCommands, getActionsBoolSource(repo, owner, "ForbidDeprecatedCommands"), useColor, indent+2)
+	displayBoolField(w, "restrict_pull_request_target", cfg.RestrictPullRequestTarget, getActionsBoolSource(repo, owner, "RestrictPullRequestTarget"), useColor, indent+2)
 
 	if cfg.MaxTimeoutMinutes != nil {
-		source := SourceOwner
-		if repo != nil && repo.MaxTimeoutMinutes != nil {
-			source = SourceRepo
-		}
+		source := fieldSource(loaded, "Checks.Actions.MaxTimeoutMinutes")
 		displayIntField(w, "max_timeout_minutes", *cfg.MaxTimeoutMinutes, source, useColor, indent+2)
 	}
 
-	if len(cfg.RequiredWorkflows) > 0 {
-		source := SourceOwner
-		if repo != nil && repo.RequiredWorkflows != nil {
-			source = SourceRepo
+	if cfg.MaxStepTimeoutMinutes != nil {
+		source := fieldSource(loaded, "Checks.Actions.MaxStepTimeoutMinutes")
+		displayIntField(w, "max_step_timeout_minutes", *cfg.MaxStepTimeoutMinutes, source, useColor, indent+2)
+	}
+
+	if cfg.RequireStepTimeoutAboveMinutes != nil {
+		source := fieldSource(loaded, "Checks.Actions.RequireStepTimeoutAboveMinutes")
+		displayIntField(w, "require_step_timeout_above_minutes", *cfg.RequireStepTimeoutAboveMinutes, source, useColor, indent+2)
+	}
+
+	if cfg.MaxScheduledWorkflows != nil {
+		source := fieldSource(loaded, "Checks.Actions.MaxScheduledWorkflows")
+		displayIntField(w, "max_scheduled_workflows", *cfg.MaxScheduledWorkflows, source, useColor, indent+2)
+	}
+
+	if cfg.MinScheduleIntervalMinutes != nil {
+		source := fieldSource(loaded, "Checks.Actions.MinScheduleIntervalMinutes")
+		displayIntField(w, "min_schedule_interval_minutes", *cfg.MinScheduleIntervalMinutes, source, useColor, indent+2)
+	}
+
+	if len(cfg.RequireDependencyCache) > 0 {
+		source := fieldSource(loaded, "Checks.Actions.RequireDependencyCache")
+		displayStringListField(w, "require_dependency_cache", cfg.RequireDependencyCache, source, useColor, indent+2)
+	}
+
+	if len(cfg.PinnedRunners) > 0 {
+		source := fieldSource(loaded, "Checks.Actions.PinnedRunners")
+		displayStringListField(w, "pinned_runners", cfg.PinnedRunners, source, useColor, indent+2)
+	}
+
+	if len(cfg.OIDCJobs) > 0 {
+		source := fieldSource(loaded, "Checks.Actions.OIDCJobs")
+		displayStringListField(w, "oidc_jobs", cfg.OIDCJobs, source, useColor, indent+2)
+	}
+
+	if len(cfg.CheckoutFetchDepth) > 0 {
+		source := fieldSource(loaded, "Checks.Actions.CheckoutFetchDepth")
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "checkout_fetch_depth:")
+		for _, rule := range cfg.CheckoutFetchDepth {
+			writeIndent(w, indent+4)
+			_, _ = fmt.Fprintf(w, "- workflows: %s\n", colorize(rule.Workflows, source, useColor))
+			displayIntField(w, "fetch_depth", rule.FetchDepth, source, useColor, indent+6)
 		}
+	}
+
+	if len(cfg.PullRequestTargetAllowlist) > 0 {
+		source := fieldSource(loaded, "Checks.Actions.PullRequestTargetAllowlist")
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "pull_request_target_allowlist:")
+		for _, entry := range cfg.PullRequestTargetAllowlist {
+			writeIndent(w, indent+4)
+			_, _ = fmt.Fprintf(w, "- workflow: %s\n", colorize(entry.Workflow, source, useColor))
+			displayStringField(w, "justification", entry.Justification, source, useColor, indent+6)
+		}
+	}
+
+	if len(cfg.RequiredWorkflows) > 0 {
+		source := fieldSource(loaded, "Checks.Actions.RequiredWorkflows")
 		displayWorkflows(w, cfg.RequiredWorkflows, source, useColor, indent+2)
 	}
 }
@@ -181,21 +392,57 @@ func displayRulesetsConfig(w io.Writer, loaded *LoadedConfig, useColor bool, ind
 	_, _ = fmt.Fprintln(w, "rulesets:")
 
 	// Rulesets are arrays - repo replaces owner entirely
-	source := SourceOwner
-	if loaded.RepoConfig != nil && loaded.RepoConfig.Checks.Rulesets != nil {
-		source = SourceRepo
-	}
+	source := fieldSource(loaded, "Checks.Rulesets")
 
 	for _, rs := range loaded.Config.Checks.Rulesets {
 		displayRuleset(w, rs, source, useColor, indent+2, validator, result)
 	}
 }
 
+func displayRulesetSetConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "ruleset_set:")
+
+	source := fieldSource(loaded, "Checks.RulesetSet")
+	// The reference is a directory, so it is not resolved like file references
+	displayStringField(w, "reference", loaded.Config.Checks.RulesetSet.Reference, source, useColor, indent+2)
+}
+
 func displayRuleset(w io.Writer, rs RulesetConfig, source Source, useColor bool, indent int, validator ReferenceValidator, result *DisplayResult) {
 	writeIndent(w, indent)
 	_, _ = fmt.Fprintln(w, "- name:", colorize(rs.Name, source, useColor))
 
-	displayReferenceField(w, "reference", rs.Reference, source, useColor, indent+2, validator, result)
+	if rs.Reference != "" {
+		displayReferenceField(w, "reference", rs.Reference, source, useColor, indent+2, validator, result)
+	}
+	if rs.Enforcement != "" {
+		displayStringField(w, "enforcement", rs.Enforcement, source, useColor, indent+2)
+	}
+	if rs.TagProtection != nil {
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "tag_protection:")
+		displayStringField(w, "pattern", rs.TagProtection.Pattern, source, useColor, indent+4)
+		if len(rs.TagProtection.Rules) > 0 {
+			displayStringField(w, "rules", strings.Join(rs.TagProtection.Rules, ", "), source, useColor, indent+4)
+		}
+	}
+	if rs.AllowedBypassActors != nil {
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "allowed_bypass_actors:")
+		for _, actor := range rs.AllowedBypassActors {
+			writeIndent(w, indent+4)
+			_, _ = fmt.Fprintf(w, "- actor_type: %s\n", colorize(actor.ActorType, source, useColor))
+			if actor.ActorID != nil {
+				displayIntField(w, "actor_id", *actor.ActorID, source, useColor, indent+6)
+			}
+		}
+	}
+	if rs.RequireBypassMode != "" {
+		displayStringField(w, "require_bypass_mode", rs.RequireBypassMode, source, useColor, indent+2)
+	}
+	if rs.BypassExpiries != "" {
+		displayReferenceField(w, "bypass_expiries", rs.BypassExpiries, source, useColor, indent+2, validator, result)
+	}
 }
 
 func displayFilesConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int, validator ReferenceValidator, result *DisplayResult) {
@@ -203,10 +450,7 @@ func displayFilesConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent
 	_, _ = fmt.Fprintln(w, "files:")
 
 	// Files are arrays - repo replaces owner entirely
-	source := SourceOwner
-	if loaded.RepoConfig != nil && loaded.RepoConfig.Checks.Files != nil {
-		source = SourceRepo
-	}
+	source := fieldSource(loaded, "Checks.Files")
 
 	for _, f := range loaded.Config.Checks.Files {
 		displayFile(w, f, source, useColor, indent+2, validator, result)
@@ -217,8 +461,280 @@ func displayFile(w io.Writer, f FileConfig, source Source, useColor bool, indent
 	writeIndent(w, indent)
 	_, _ = fmt.Fprintln(w, "- name:", colorize(f.Name, source, useColor))
 
-	displayReferenceField(w, "reference", f.Reference, source, useColor, indent+2, validator, result)
+	if f.Reference != "" || len(f.LanguageReferences) == 0 {
+		displayReferenceField(w, "reference", f.Reference, source, useColor, indent+2, validator, result)
+	}
+	if len(f.LanguageReferences) > 0 {
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "language_references:")
+		for _, language := range slices.Sorted(maps.Keys(f.LanguageReferences)) {
+			displayReferenceField(w, language, f.LanguageReferences[language], source, useColor, indent+4, validator, result)
+		}
+	}
+	displayBoolField(w, "detect_drift", f.DetectDrift, source, useColor, indent+2)
+	if len(f.IgnorePatterns) > 0 {
+		displayStringListField(w, "ignore_patterns", f.IgnorePatterns, source, useColor, indent+2)
+	}
+}
+
+func displayAutolinksConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "autolinks:")
+
+	// Autolinks are arrays - repo replaces owner entirely
+	source := fieldSource(loaded, "Checks.Autolinks")
+
+	for _, al := range loaded.Config.Checks.Autolinks {
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "- key_prefix:", colorize(al.KeyPrefix, source, useColor))
+		displayStringField(w, "url_template", al.URLTemplate, source, useColor, indent+4)
+		displayBoolField(w, "is_alphanumeric", al.IsAlphanumeric, source, useColor, indent+4)
+	}
+}
+
+func displayLabelsConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "labels:")
+
+	// Labels are arrays - repo replaces owner entirely
+	source := fieldSource(loaded, "Checks.Labels")
+
+	for _, l := range loaded.Config.Checks.Labels {
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "- name:", colorize(l.Name, source, useColor))
+		if l.Color != "" {
+			displayStringField(w, "color", l.Color, source, useColor, indent+4)
+		}
+		if l.Description != "" {
+			displayStringField(w, "description", l.Description, source, useColor, indent+4)
+		}
+	}
+}
+
+func displayCustomConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "custom:")
+
+	// Custom checks are arrays - repo replaces owner entirely
+	source := fieldSource(loaded, "Checks.Custom")
+
+	for _, cc := range loaded.Config.Checks.Custom {
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "- name:", colorize(cc.Name, source, useColor))
+		displayStringListField(w, "exec", cc.Exec, source, useColor, indent+4)
+	}
+}
+
+func displayTopicsConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "topics:")
+
+	cfg := loaded.Config.Checks.Topics
+
+	if len(cfg.RequiredTopics) > 0 {
+		source := fieldSource(loaded, "Checks.Topics.RequiredTopics")
+		displayStringListField(w, "required_topics", cfg.RequiredTopics, source, useColor, indent+2)
+	}
+
+	if len(cfg.AllowedTopics) > 0 {
+		source := fieldSource(loaded, "Checks.Topics.AllowedTopics")
+		displayStringListField(w, "allowed_topics", cfg.AllowedTopics, source, useColor, indent+2)
+	}
+}
+
+func displayLanguagesConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "languages:")
+
+	cfg := loaded.Config.Checks.Languages
+
+	if cfg.Primary != "" {
+		source := fieldSource(loaded, "Checks.Languages.Primary")
+		displayStringField(w, "primary", cfg.Primary, source, useColor, indent+2)
+	}
+
+	if len(cfg.Forbidden) > 0 {
+		source := fieldSource(loaded, "Checks.Languages.Forbidden")
+		displayStringListField(w, "forbidden", cfg.Forbidden, source, useColor, indent+2)
+	}
+
+	if cfg.ForbiddenThresholdPercent != nil {
+		source := fieldSource(loaded, "Checks.Languages.ForbiddenThresholdPercent")
+		displayIntField(w, "forbidden_threshold_percent", *cfg.ForbiddenThresholdPercent, source, useColor, indent+2)
+	}
+}
+
+func displayBranchRulesConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "branch_rules:")
+
+	cfg := loaded.Config.Checks.BranchRules
+	source := func(field string) Source {
+		return fieldSource(loaded, "Checks.BranchRules."+field)
+	}
+
+	if cfg.RulesetName != "" {
+		displayStringField(w, "ruleset_name", cfg.RulesetName, source("RulesetName"), useColor, indent+2)
+	}
+	displayBoolField(w, "require_pull_request", cfg.RequirePullRequest, source("RequirePullRequest"), useColor, indent+2)
+	if cfg.RequiredApprovals != nil {
+		displayIntField(w, "required_approvals", *cfg.RequiredApprovals, source("RequiredApprovals"), useColor, indent+2)
+	}
+	displayBoolField(w, "require_linear_history", cfg.RequireLinearHistory, source("RequireLinearHistory"), useColor, indent+2)
+	if len(cfg.RequireStatusChecks) > 0 {
+		displayStringListField(w, "require_status_checks", cfg.RequireStatusChecks, source("RequireStatusChecks"), useColor, indent+2)
+	}
+}
+
+func displayReadmeConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "readme:")
+
+	cfg := loaded.Config.Checks.Readme
+	if len(cfg.RequiredSections) > 0 {
+		source := fieldSource(loaded, "Checks.Readme.RequiredSections")
+		displayStringListField(w, "required_sections", cfg.RequiredSections, source, useColor, indent+2)
+	}
+}
+
+func displayCheckRunsConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "check_runs:")
+
+	cfg := loaded.Config.Checks.CheckRuns
+	if len(cfg.Required) > 0 {
+		source := fieldSource(loaded, "Checks.CheckRuns.Required")
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "required:")
+		for _, run := range cfg.Required {
+			writeIndent(w, indent+4)
+			_, _ = fmt.Fprintf(w, "- name: %s\n", colorize(run.Name, source, useColor))
+			if run.App != "" {
+				displayStringField(w, "app", run.App, source, useColor, indent+6)
+			}
+		}
+	}
+}
+
+func displayTemplateStructureConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "template_structure:")
+
+	cfg := loaded.Config.Checks.TemplateStructure
+
+	if len(cfg.PullRequestHeadings) > 0 {
+		source := fieldSource(loaded, "Checks.TemplateStructure.PullRequestHeadings")
+		displayStringListField(w, "pull_request_headings", cfg.PullRequestHeadings, source, useColor, indent+2)
+	}
+
+	if len(cfg.IssueForms) > 0 {
+		source := fieldSource(loaded, "Checks.TemplateStructure.IssueForms")
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "issue_forms:")
+		for _, form := range cfg.IssueForms {
+			forms := form.Forms
+			if forms == "" {
+				forms = "*"
+			}
+			writeIndent(w, indent+4)
+			_, _ = fmt.Fprintln(w, "- forms:", colorize(forms, source, useColor))
+			displayStringListField(w, "required_fields", form.RequiredFields, source, useColor, indent+6)
+		}
+	}
+}
+
+func displayBranchesConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "branches:")
+
+	cfg := loaded.Config.Checks.Branches
+
+	if cfg.StaleAfterDays != nil {
+		source := fieldSource(loaded, "Checks.Branches.StaleAfterDays")
+		displayIntField(w, "stale_after_days", *cfg.StaleAfterDays, source, useColor, indent+2)
+	}
+
+	if len(cfg.ForbiddenBranches) > 0 {
+		source := fieldSource(loaded, "Checks.Branches.ForbiddenBranches")
+		displayStringListField(w, "forbidden_branches", cfg.ForbiddenBranches, source, useColor, indent+2)
+	}
+}
+
+func displayDependabotConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "dependabot:")
+
+	cfg := loaded.Config.Checks.Dependabot
+
+	if cfg.RequireOpenPullRequestsLimit != nil {
+		source := fieldSource(loaded, "Checks.Dependabot.RequireOpenPullRequestsLimit")
+		displayBoolField(w, "require_open_pull_requests_limit", cfg.RequireOpenPullRequestsLimit, source, useColor, indent+2)
+	}
+
+	if cfg.MaxOpenPullRequestsLimit != nil {
+		source := fieldSource(loaded, "Checks.Dependabot.MaxOpenPullRequestsLimit")
+		displayIntField(w, "max_open_pull_requests_limit", *cfg.MaxOpenPullRequestsLimit, source, useColor, indent+2)
+	}
+
+	if tb := cfg.TargetBranch; tb != nil {
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "target_branch:")
+		if tb.Branch != "" {
+			source := fieldSource(loaded, "Checks.Dependabot.TargetBranch.Branch")
+			displayStringField(w, "branch", tb.Branch, source, useColor, indent+4)
+		}
+		if len(tb.Ecosystems) > 0 {
+			source := fieldSource(loaded, "Checks.Dependabot.TargetBranch.Ecosystems")
+			displayStringListField(w, "ecosystems", tb.Ecosystems, source, useColor, indent+4)
+		}
+	}
+
+	if len(cfg.ScheduleIntervals) > 0 {
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintln(w, "schedule_intervals:")
+		// Intervals are merged per ecosystem - repo keys override owner keys
+		for _, ecosystem := range slices.Sorted(maps.Keys(cfg.ScheduleIntervals)) {
+			source := keySource(loaded, "Checks.Dependabot.ScheduleIntervals", ecosystem)
+			displayStringField(w, ecosystem, cfg.ScheduleIntervals[ecosystem], source, useColor, indent+4)
+		}
+	}
+}
+
+func displayOrganizationConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "organization:")
 
+	// Organization settings come from the owner config, or from the base config when the
+	// owner config does not set them
+	cfg := loaded.Config.Checks.Organization
+	source := SourceOwner
+	if (loaded.OwnerConfig == nil || loaded.OwnerConfig.Checks.Organization == nil) && loaded.BaseConfig != nil && loaded.BaseConfig.Checks.Organization != nil {
+		source = SourceBase
+	}
+	if cfg.DefaultRepositoryPermission != "" {
+		displayStringField(w, "default_repository_permission", cfg.DefaultRepositoryPermission, source, useColor, indent+2)
+	}
+	displayBoolField(w, "members_can_create_repositories", cfg.MembersCanCreateRepositories, source, useColor, indent+2)
+	if cfg.DefaultWorkflowPermissions != "" {
+		displayStringField(w, "default_workflow_permissions", cfg.DefaultWorkflowPermissions, source, useColor, indent+2)
+	}
+}
+
+func displayFundingConfig(w io.Writer, loaded *LoadedConfig, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintln(w, "funding:")
+
+	cfg := loaded.Config.Checks.Funding
+
+	if len(cfg.GitHub) > 0 {
+		source := fieldSource(loaded, "Checks.Funding.GitHub")
+		displayStringListField(w, "github", cfg.GitHub, source, useColor, indent+2)
+	}
+
+	if len(cfg.Custom) > 0 {
+		source := fieldSource(loaded, "Checks.Funding.Custom")
+		displayStringListField(w, "custom", cfg.Custom, source, useColor, indent+2)
+	}
 }
 
 func displayWorkflows(w io.Writer, workflows []WorkflowConfig, source Source, useColor bool, indent int) {
@@ -232,6 +748,12 @@ func displayWorkflows(w io.Writer, workflows []WorkflowConfig, source Source, us
 			writeIndent(w, indent+4)
 			_, _ = fmt.Fprintf(w, "reference: %s\n", colorize(wf.Reference, source, useColor))
 		}
+		if len(wf.RequiredJobs) > 0 {
+			displayStringListField(w, "required_jobs", wf.RequiredJobs, source, useColor, indent+4)
+		}
+		if wf.IgnorePinnedVersions != nil {
+			displayBoolField(w, "ignore_pinned_versions", wf.IgnorePinnedVersions, source, useColor, indent+4)
+		}
 	}
 }
 
@@ -254,6 +776,15 @@ func displayStringField(w io.Writer, name string, value string, source Source, u
 	_, _ = fmt.Fprintf(w, "%s: %s\n", name, colorize(value, source, useColor))
 }
 
+func displayStringListField(w io.Writer, name string, values []string, source Source, useColor bool, indent int) {
+	writeIndent(w, indent)
+	_, _ = fmt.Fprintf(w, "%s:\n", name)
+	for _, value := range values {
+		writeIndent(w, indent+2)
+		_, _ = fmt.Fprintf(w, "- %s\n", colorize(value, source, useColor))
+	}
+}
+
 func displayReferenceField(w io.Writer, name string, value string, source Source, useColor bool, indent int, validator ReferenceValidator, result *DisplayResult) {
 	writeIndent(w, indent)
 
@@ -312,6 +843,60 @@ func colorize(value string, source Source, useColor bool) string {
 
 // Source detection helpers
 
+// fieldSource returns where a merged field came from: the repo config if it sets the field,
+// otherwise the owner config if it does, otherwise the base config. path is the dotted Go
+// field path below Config, such as "Checks.Actions.MaxTimeoutMinutes".
+func fieldSource(loaded *LoadedConfig, path string) Source {
+	if v, ok := configField(loaded.RepoConfig, path); ok && !v.IsZero() {
+		return SourceRepo
+	}
+	if v, ok := configField(loaded.OwnerConfig, path); ok && !v.IsZero() {
+		return SourceOwner
+	}
+	return SourceBase
+}
+
+// keySource is fieldSource for one key of a map that is merged per key
+func keySource(loaded *LoadedConfig, path, key string) Source {
+	has := func(cfg *Config) bool {
+		v, ok := configField(cfg, path)
+		if !ok || v.IsNil() {
+			return false
+		}
+		return v.MapIndex(reflect.ValueOf(key).Convert(v.Type().Key())).IsValid()
+	}
+	switch {
+	case has(loaded.RepoConfig):
+		return SourceRepo
+	case has(loaded.OwnerConfig):
+		return SourceOwner
+	default:
+		return SourceBase
+	}
+}
+
+// configField walks a dotted field path from cfg, returning false if cfg or a struct
+// pointer on the path is nil
+func configField(cfg *Config, path string) (reflect.Value, bool) {
+	if cfg == nil {
+		return reflect.Value{}, false
+	}
+	v := reflect.ValueOf(cfg).Elem()
+	for _, name := range strings.Split(path, ".") {
+		if v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.FieldByName(name)
+		if !v.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+	return v, true
+}
+
 func getRepoSettings(loaded *LoadedConfig) *SettingsConfig {
 	if loaded.RepoConfig != nil {
 		return loaded.RepoConfig.Checks.Settings
@@ -353,7 +938,7 @@ func getBoolSource(repo, owner *SettingsConfig, field string) Source {
 			return SourceOwner
 		}
 	}
-	return SourceNone
+	return SourceBase
 }
 
 func getMergeBoolSource(repo, owner *MergeConfig, field string) Source {
@@ -369,7 +954,23 @@ func getMergeBoolSource(repo, owner *MergeConfig, field string) Source {
 			return SourceOwner
 		}
 	}
-	return SourceNone
+	return SourceBase
+}
+
+func getMergeQueueSource(repo, owner *MergeQueueConfig, field string) Source {
+	if repo != nil {
+		v := reflect.ValueOf(repo).Elem().FieldByName(field)
+		if v.IsValid() && !v.IsNil() {
+			return SourceRepo
+		}
+	}
+	if owner != nil {
+		v := reflect.ValueOf(owner).Elem().FieldByName(field)
+		if v.IsValid() && !v.IsNil() {
+			return SourceOwner
+		}
+	}
+	return SourceBase
 }
 
 func getActionsBoolSource(repo, owner *ActionsConfig, field string) Source {
@@ -385,7 +986,7 @@ func getActionsBoolSource(repo, owner *ActionsConfig, field string) Source {
 			return SourceOwner
 		}
 	}
-	return SourceNone
+	return SourceBase
 }
 
 func getDependabotBoolSource(repo, owner *DependabotSettingsConfig, field string) Source {
@@ -401,5 +1002,5 @@ func getDependabotBoolSource(repo, owner *DependabotSettingsConfig, field string
 			return SourceOwner
 		}
 	}
-	return SourceNone
+	return SourceBase
 }
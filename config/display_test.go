@@ -0,0 +1,41 @@
+package config
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDisplayConfig_Sources(t *testing.T) {
+	base := &Config{Checks: ChecksConfig{
+		Actions:  &ActionsConfig{PinnedRunners: []string{"ubuntu-24.04"}},
+		HelpURLs: map[string]string{"files": "https://example.com/files"},
+	}}
+	owner := &Config{Checks: ChecksConfig{
+		Actions: &ActionsConfig{OIDCJobs: []string{"deploy"}},
+	}}
+	repo := &Config{Checks: ChecksConfig{
+		HelpURLs: map[string]string{"labels": "https://example.com/labels"},
+	}}
+	loaded := &LoadedConfig{
+		Config:      MergeConfigs(MergeConfigs(base, owner), repo),
+		RepoConfig:  repo,
+		OwnerConfig: owner,
+		BaseConfig:  base,
+		BaseSource:  "acme/policy/base.yaml",
+	}
+
+	var out bytes.Buffer
+	DisplayConfig(&out, loaded, false, nil)
+
+	for _, want := range []string{
+		"- ubuntu-24.04\n",
+		"- deploy [owner]\n",
+		"files: https://example.com/files\n",
+		"labels: https://example.com/labels [repo]\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("DisplayConfig() output missing %q:\n%s", want, out.String())
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fragmentOverrideKey is the top-level key that lets a config fragment override values
+// set by earlier fragments
+const fragmentOverrideKey = "override"
+
+// fragmentValue is a value set by a config fragment
+type fragmentValue struct {
+	value any
+	file  string
+}
+
+// fragmentValues records the values a fragment sets by dotted key path. Maps are walked
+// into; scalars and lists, which MergeConfigs replaces rather than merges, are values.
+func fragmentValues(prefix string, node map[string]any, values map[string]any) {
+	for key, value := range node {
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+		if child, ok := value.(map[string]any); ok {
+			fragmentValues(path, child, values)
+			continue
+		}
+		values[path] = value
+	}
+}
+
+// fragmentConflicts records the values set by a fragment and returns the keys that an
+// earlier fragment already set to a different value, sorted. With override, the
+// fragment's values replace the earlier ones without conflict.
+func fragmentConflicts(seen map[string]fragmentValue, file string, values map[string]any, override bool) []string {
+	var conflicts []string
+	for _, path := range slices.Sorted(maps.Keys(values)) {
+		if previous, ok := seen[path]; ok && !override && !reflect.DeepEqual(previous.value, values[path]) {
+			conflicts = append(conflicts, fmt.Sprintf("%s (set by %s and %s)", path, previous.file, file))
+			continue
+		}
+		seen[path] = fragmentValue{value: values[path], file: file}
+	}
+	return conflicts
+}
+
+// fragmentFiles returns the *.yaml and *.yml files of dir in lexical order
+func fragmentFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config directory: %w", err)
+	}
+	var files []string
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			files = append(files, entry.Name())
+		}
+	}
+	slices.Sort(files)
+	return files, nil
+}
+
+// LoadFromDir loads configuration from the *.yaml fragments of a directory, each a
+// partial config, merged in lexical order of file name
+// This bypasses normal config discovery. Fragments may not set the same key to
+// different values unless the later fragment sets override: true.
+func (l *Loader) LoadFromDir(dir string) (*LoadedConfig, error) {
+	files, err := fragmentFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no *.yaml config fragments in %s", dir)
+	}
+
+	var merged *Config
+	seen := make(map[string]fragmentValue)
+	var conflicts []string
+	for _, name := range files {
+		path := filepath.Join(dir, name)
+		data, err := os.ReadFile(path) //nolint:gosec // Reading config from user-specified path is intentional
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config fragment: %w", err)
+		}
+		cfg, err := parseConfigBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse config from %s: %w", path, err)
+		}
+
+		var raw map[string]any
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("failed to parse config from %s: %w", path, err)
+		}
+		override, _ := raw[fragmentOverrideKey].(bool)
+		delete(raw, fragmentOverrideKey)
+		values := make(map[string]any)
+		fragmentValues("", raw, values)
+		conflicts = append(conflicts, fragmentConflicts(seen, name, values, override)...)
+
+		merged = MergeConfigs(merged, cfg)
+		// MergeConfigs only takes organization settings from the owner side, but any
+		// fragment may declare them
+		if cfg.Checks.Organization != nil {
+			merged.Checks.Organization = cfg.Checks.Organization
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, fmt.Errorf("conflicting values in config fragments of %s (set %s: true in the later fragment to override): %s",
+			dir, fragmentOverrideKey, strings.Join(conflicts, ", "))
+	}
+	if err := validateConfig(merged); err != nil {
+		return nil, fmt.Errorf("invalid config in %s: %w", dir, err)
+	}
+
+	result := &LoadedConfig{
+		Config:     merged,
+		RepoConfig: merged,
+		RepoSource: dir,
+	}
+	if err := l.applyBase(result); err != nil {
+		return nil, err
+	}
+	if err := validateMergeMethods(result.Config); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFragmentConflicts(t *testing.T) {
+	seen := make(map[string]fragmentValue)
+	first := make(map[string]any)
+	fragmentValues("", map[string]any{
+		"checks": map[string]any{
+			"settings": map[string]any{"wiki": false, "issues": true},
+			"topics":   map[string]any{"required": []any{"go"}},
+		},
+	}, first)
+	if got := fragmentConflicts(seen, "a.yaml", first, false); got != nil {
+		t.Fatalf("fragmentConflicts() for the first fragment = %v, want none", got)
+	}
+
+	second := make(map[string]any)
+	fragmentValues("", map[string]any{
+		"checks": map[string]any{
+			"settings": map[string]any{"wiki": true, "issues": true, "projects": false},
+			"topics":   map[string]any{"required": []any{"go", "cli"}},
+		},
+	}, second)
+	want := []string{
+		"checks.settings.wiki (set by a.yaml and b.yaml)",
+		"checks.topics.required (set by a.yaml and b.yaml)",
+	}
+	if got := fragmentConflicts(seen, "b.yaml", second, false); !slices.Equal(got, want) {
+		t.Errorf("fragmentConflicts() = %v, want %v", got, want)
+	}
+	if got := fragmentConflicts(seen, "c.yaml", second, true); got != nil {
+		t.Errorf("fragmentConflicts() with override = %v, want none", got)
+	}
+}
+
+func TestLoadFromDir(t *testing.T) {
+	write := func(dir, name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	dir := t.TempDir()
+	write(dir, "settings.yaml", "checks:\n  settings:\n    wiki: false\n")
+	write(dir, "actions.yaml", "checks:\n  actions:\n    require_timeout: true\n")
+	write(dir, "zz-local.yaml", "override: true\nchecks:\n  settings:\n    wiki: true\n")
+	write(dir, "notes.txt", "not a fragment")
+
+	loaded, err := (&Loader{}).LoadFromDir(dir)
+	if err != nil {
+		t.Fatalf("LoadFromDir() returned unexpected error: %v", err)
+	}
+	cfg := loaded.Config
+	if cfg.Checks.Actions == nil || cfg.Checks.Actions.RequireTimeout == nil || !*cfg.Checks.Actions.RequireTimeout {
+		t.Errorf("LoadFromDir() actions.require_timeout = %v, want true", cfg.Checks.Actions)
+	}
+	if cfg.Checks.Settings == nil || cfg.Checks.Settings.Wiki == nil || !*cfg.Checks.Settings.Wiki {
+		t.Errorf("LoadFromDir() settings.wiki not overridden to true")
+	}
+
+	write(dir, "zz-local.yaml", "checks:\n  settings:\n    wiki: true\n")
+	if _, err := (&Loader{}).LoadFromDir(dir); err == nil || !strings.Contains(err.Error(), "checks.settings.wiki") {
+		t.Errorf("LoadFromDir() error = %v, want a conflict on checks.settings.wiki", err)
+	}
+}
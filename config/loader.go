@@ -5,11 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/cli/go-gh/v2/pkg/api"
+	"github.com/gobwas/glob"
 	"github.com/sethrylan/gh-repolint/github"
 	"gopkg.in/yaml.v3"
 )
@@ -17,6 +22,17 @@ import (
 // ConfigFileNames contains the candidate config file names in priority order
 var ConfigFileNames = []string{".repolint.yaml", ".repolint.yml"}
 
+// labelColorPattern matches a label color as accepted by the GitHub API
+var labelColorPattern = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// helpURLCheckTypes are the check types that help_urls may be set for, matching the
+// CheckType values of package checks
+var helpURLCheckTypes = []string{
+	"settings", "actions", "rulesets", "files", "autolinks", "branches", "funding", "labels", "topics",
+	"languages", "template_structure", "branch_rules", "readme", "check_runs", "consistency",
+	"organization", "dependabot", "custom",
+}
+
 // Source indicates where a config was loaded from
 type Source int
 
@@ -25,6 +41,8 @@ const (
 	SourceNone Source = iota
 	SourceRepo
 	SourceOwner
+	// SourceBase marks values set only by the base config, which are shown unannotated
+	SourceBase
 )
 
 func (s Source) String() string {
@@ -33,6 +51,8 @@ func (s Source) String() string {
 		return "repo"
 	case SourceOwner:
 		return "owner"
+	case SourceBase:
+		return "base"
 	default:
 		return "none"
 	}
@@ -43,24 +63,58 @@ type LoadedConfig struct {
 	Config      *Config
 	RepoConfig  *Config
 	OwnerConfig *Config
+	// BaseConfig is the shared config declared by the repo or owner config's base key
+	BaseConfig  *Config
 	RepoSource  string
 	OwnerSource string
+	BaseSource  string
 }
 
 // Loader handles configuration discovery and loading
 type Loader struct {
 	client *api.RESTClient
+	github *github.Client
 	owner  string
 	repo   string
+	// ownerRef is the branch, tag or commit the owner config is read at; empty means
+	// the owner config repository's default branch
+	ownerRef string
+	// requireOwner makes a missing owner config an error
+	requireOwner bool
+	// bases caches fetched base configs by reference
+	bases map[string]*Config
 }
 
 // NewLoader creates a new config loader
 func NewLoader(client *github.Client) *Loader {
 	return &Loader{
 		client: client.RESTClient(),
+		github: client,
 		owner:  client.Owner(),
 		repo:   client.Repo(),
+		bases:  make(map[string]*Config),
+	}
+}
+
+// SetOwnerConfigRef reads the owner config at a branch, tag or commit instead of the
+// default branch of the <owner>/<owner> repository
+func (l *Loader) SetOwnerConfigRef(ref string) {
+	l.ownerRef = ref
+}
+
+// SetRequireOwnerConfig makes Load fail when the owner has no config in its <owner>/<owner>
+// repository, instead of proceeding with the repo config alone
+func (l *Loader) SetRequireOwnerConfig(required bool) {
+	l.requireOwner = required
+}
+
+// ownerSource describes where the owner config was read from
+func (l *Loader) ownerSource(fileName string) string {
+	source := fmt.Sprintf("%s/%s/%s", l.owner, l.owner, fileName)
+	if l.ownerRef != "" {
+		source += "@" + l.ownerRef
 	}
+	return source
 }
 
 // Load discovers and loads configuration files
@@ -85,7 +139,11 @@ func (l *Loader) Load() (*LoadedConfig, error) {
 	}
 	if ownerConfig != nil {
 		result.OwnerConfig = ownerConfig
-		result.OwnerSource = fmt.Sprintf("%s/%s/%s", l.owner, l.owner, ownerFileName)
+		result.OwnerSource = l.ownerSource(ownerFileName)
+	} else if l.requireOwner && l.repo != l.owner {
+		// The <owner>/<owner> repository's own config is its owner config
+		return nil, fmt.Errorf("owner config is required but was not found: checked %s/%s/{%s}",
+			l.owner, l.owner, strings.Join(ConfigFileNames, ","))
 	}
 
 	// If neither exists, return error
@@ -94,12 +152,49 @@ func (l *Loader) Load() (*LoadedConfig, error) {
 			l.owner, l.repo, strings.Join(ConfigFileNames, ","), l.owner, l.owner, strings.Join(ConfigFileNames, ","))
 	}
 
-	// Merge configs (repo takes precedence over owner)
+	// Merge configs (repo takes precedence over owner, which takes precedence over base)
 	result.Config = MergeConfigs(result.OwnerConfig, result.RepoConfig)
+	if err := l.applyBase(result); err != nil {
+		return nil, err
+	}
+
+	// Each file is valid on its own, but the merged merge methods may still all be disabled
+	if err := validateMergeMethods(result.Config); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// LoadOwner loads only the owner-level config from the <owner>/<owner> repository
+// This is used when the repository is not checked out locally (e.g. org scans)
+func (l *Loader) LoadOwner() (*LoadedConfig, error) {
+	ownerConfig, ownerFileName, err := l.loadOwnerConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error loading owner config: %w", err)
+	}
+	if ownerConfig == nil {
+		return nil, fmt.Errorf("no configuration found: checked %s/%s/{%s}",
+			l.owner, l.owner, strings.Join(ConfigFileNames, ","))
+	}
 
+	result := &LoadedConfig{
+		Config:      MergeConfigs(ownerConfig, nil),
+		OwnerConfig: ownerConfig,
+		OwnerSource: l.ownerSource(ownerFileName),
+	}
+	if err := l.applyBase(result); err != nil {
+		return nil, err
+	}
+	if err := validateMergeMethods(result.Config); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
 
+// StdinConfigPath is the --config value that reads configuration from standard input
+const StdinConfigPath = "-"
+
 // LoadFromFile loads configuration from a specific file path
 // This bypasses normal config discovery and uses only the specified file
 func (l *Loader) LoadFromFile(path string) (*LoadedConfig, error) {
@@ -109,17 +204,30 @@ func (l *Loader) LoadFromFile(path string) (*LoadedConfig, error) {
 	}
 	defer func() { _ = file.Close() }()
 
-	cfg, err := parseConfig(file)
+	return l.LoadFromReader(file, path)
+}
+
+// LoadFromReader loads configuration from a reader (e.g. stdin)
+// This bypasses normal config discovery; source is used for display only
+func (l *Loader) LoadFromReader(r io.Reader, source string) (*LoadedConfig, error) {
+	cfg, err := parseConfig(r)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse config file: %w", err)
+		return nil, fmt.Errorf("failed to parse config from %s: %w", source, err)
 	}
 
-	return &LoadedConfig{
+	result := &LoadedConfig{
 		Config:     cfg,
 		RepoConfig: cfg,
-		RepoSource: path,
+		RepoSource: source,
 		// OwnerConfig and OwnerSource are intentionally left nil/empty
-	}, nil
+	}
+	if err := l.applyBase(result); err != nil {
+		return nil, err
+	}
+	if err := validateMergeMethods(result.Config); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // findConfigFile returns the path of the first existing config file from candidates,
@@ -182,11 +290,16 @@ func (l *Loader) loadOwnerConfig() (*Config, string, error) {
 	for _, name := range ConfigFileNames {
 		// Fetch from GitHub API: GET /repos/{owner}/{owner}/contents/{path}
 		path := fmt.Sprintf("repos/%s/%s/contents/%s", l.owner, l.owner, name)
+		if l.ownerRef != "" {
+			path += "?ref=" + url.QueryEscape(l.ownerRef)
+		}
 
-		err := l.client.Get(path, &content)
-		if err != nil {
-			// If 404, try next filename
-			continue
+		if err := l.client.Get(path, &content); err != nil {
+			// If 404, try next filename; anything else (403, 5xx) must not read as "no config"
+			if github.IsNotFound(err) {
+				continue
+			}
+			return nil, "", fmt.Errorf("failed to fetch %s/%s/%s: %w", l.owner, l.owner, name, err)
 		}
 
 		if content.Encoding != "base64" {
@@ -206,6 +319,12 @@ func (l *Loader) loadOwnerConfig() (*Config, string, error) {
 		return cfg, name, nil
 	}
 
+	// A ref that does not exist is also a 404, so a pinned ref must find a config
+	if l.ownerRef != "" {
+		return nil, "", fmt.Errorf("no config found in %s/%s at ref %q: checked {%s}",
+			l.owner, l.owner, l.ownerRef, strings.Join(ConfigFileNames, ","))
+	}
+
 	// No config file found - not an error
 	return nil, "", nil
 }
@@ -233,6 +352,9 @@ func parseConfigBytes(data []byte) (*Config, error) {
 
 // validateConfig validates parsed config values
 func validateConfig(cfg *Config) error {
+	if err := validateMergeMethods(cfg); err != nil {
+		return err
+	}
 	if cfg.Checks.Settings != nil && cfg.Checks.Settings.PullRequestCreationPolicy != "" {
 		switch cfg.Checks.Settings.PullRequestCreationPolicy {
 		case "all", "collaborators_only":
@@ -242,6 +364,291 @@ func validateConfig(cfg *Config) error {
 				cfg.Checks.Settings.PullRequestCreationPolicy)
 		}
 	}
+	if cfg.Checks.Settings != nil && cfg.Checks.Settings.Merge != nil && cfg.Checks.Settings.Merge.SquashMergeCommitMessage != "" {
+		switch cfg.Checks.Settings.Merge.SquashMergeCommitMessage {
+		case "PR_BODY", "COMMIT_MESSAGES", "BLANK":
+			// valid
+		default:
+			return fmt.Errorf("invalid squash_merge_commit_message: %q (must be \"PR_BODY\", \"COMMIT_MESSAGES\" or \"BLANK\")",
+				cfg.Checks.Settings.Merge.SquashMergeCommitMessage)
+		}
+	}
+	if cfg.Checks.Settings != nil && cfg.Checks.Settings.MergeQueue != nil {
+		if err := validateMergeQueue(cfg.Checks.Settings.MergeQueue); err != nil {
+			return err
+		}
+	}
+	if settings := cfg.Checks.Settings; settings != nil && settings.MergeTiers != nil {
+		if settings.MergeTiers.Property == "" {
+			return errors.New("merge_tiers missing required property field")
+		}
+		if settings.MergeTiers.Reference == "" {
+			return errors.New("merge_tiers missing required reference field")
+		}
+	}
+	if cfg.Checks.RulesetSet != nil && cfg.Checks.RulesetSet.Reference == "" {
+		return errors.New("ruleset_set missing required reference field")
+	}
+	for _, rs := range cfg.Checks.Rulesets {
+		switch rs.Enforcement {
+		case "", "active", "evaluate", "disabled":
+			// valid
+		default:
+			return fmt.Errorf("invalid enforcement for ruleset %q: %q (must be \"active\", \"evaluate\" or \"disabled\")",
+				rs.Name, rs.Enforcement)
+		}
+		if rs.TagProtection != nil {
+			if rs.TagProtection.Pattern == "" {
+				return fmt.Errorf("tag_protection for ruleset %q missing required pattern field", rs.Name)
+			}
+			for _, rule := range rs.TagProtection.Rules {
+				switch rule {
+				case "update", "deletion", "non_fast_forward":
+					// valid
+				default:
+					return fmt.Errorf("invalid tag_protection rule for ruleset %q: %q (must be \"update\", \"deletion\" or \"non_fast_forward\")",
+						rs.Name, rule)
+				}
+			}
+		}
+		switch rs.RequireBypassMode {
+		case "", "pull_request":
+			// valid
+		default:
+			return fmt.Errorf("invalid require_bypass_mode for ruleset %q: %q (must be \"pull_request\")",
+				rs.Name, rs.RequireBypassMode)
+		}
+		for _, actor := range rs.AllowedBypassActors {
+			switch actor.ActorType {
+			case "Integration", "OrganizationAdmin", "RepositoryRole", "Team", "DeployKey":
+				// valid
+			default:
+				return fmt.Errorf("invalid allowed_bypass_actors actor_type for ruleset %q: %q (must be Integration, OrganizationAdmin, RepositoryRole, Team or DeployKey)",
+					rs.Name, actor.ActorType)
+			}
+		}
+	}
+	for _, checkType := range slices.Sorted(maps.Keys(cfg.Checks.HelpURLs)) {
+		if !slices.Contains(helpURLCheckTypes, checkType) {
+			return fmt.Errorf("invalid help_urls check type: %q (must be one of %s)", checkType, strings.Join(helpURLCheckTypes, ", "))
+		}
+	}
+	for _, al := range cfg.Checks.Autolinks {
+		if al.KeyPrefix == "" {
+			return errors.New("autolink missing required key_prefix field")
+		}
+		if !strings.Contains(al.URLTemplate, "<num>") {
+			return fmt.Errorf("invalid url_template for autolink %q: %q (must contain <num>)", al.KeyPrefix, al.URLTemplate)
+		}
+	}
+	for _, l := range cfg.Checks.Labels {
+		if l.Name == "" {
+			return errors.New("label missing required name field")
+		}
+		if l.Color != "" && !labelColorPattern.MatchString(l.Color) {
+			return fmt.Errorf("invalid color for label %q: %q (must be a 6-digit hex code without #)", l.Name, l.Color)
+		}
+	}
+	customNames := make(map[string]bool)
+	for _, cc := range cfg.Checks.Custom {
+		if cc.Name == "" {
+			return errors.New("custom check missing required name field")
+		}
+		if customNames[cc.Name] {
+			return fmt.Errorf("duplicate custom check name: %q", cc.Name)
+		}
+		customNames[cc.Name] = true
+		if len(cc.Exec) == 0 || cc.Exec[0] == "" {
+			return fmt.Errorf("custom check %q missing required exec field", cc.Name)
+		}
+	}
+	if cfg.Checks.Organization != nil && cfg.Checks.Organization.DefaultRepositoryPermission != "" {
+		switch cfg.Checks.Organization.DefaultRepositoryPermission {
+		case "read", "write", "admin", "none":
+		default:
+			return fmt.Errorf("invalid default_repository_permission: %q (must be read, write, admin or none)",
+				cfg.Checks.Organization.DefaultRepositoryPermission)
+		}
+	}
+	if cfg.Checks.Organization != nil && cfg.Checks.Organization.DefaultWorkflowPermissions != "" {
+		switch cfg.Checks.Organization.DefaultWorkflowPermissions {
+		case "read", "write":
+		default:
+			return fmt.Errorf("invalid default_workflow_permissions: %q (must be read or write)",
+				cfg.Checks.Organization.DefaultWorkflowPermissions)
+		}
+	}
+	for _, f := range cfg.Checks.Files {
+		for _, pattern := range f.IgnorePatterns {
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid ignore_patterns entry %q for file %q: %w", pattern, f.Name, err)
+			}
+		}
+	}
+	if cfg.Checks.Dependabot != nil && cfg.Checks.Dependabot.MaxOpenPullRequestsLimit != nil && *cfg.Checks.Dependabot.MaxOpenPullRequestsLimit < 0 {
+		return fmt.Errorf("invalid max_open_pull_requests_limit: %d (must be 0 or greater)", *cfg.Checks.Dependabot.MaxOpenPullRequestsLimit)
+	}
+	if cfg.Checks.Dependabot != nil {
+		intervals := cfg.Checks.Dependabot.ScheduleIntervals
+		for _, ecosystem := range slices.Sorted(maps.Keys(intervals)) {
+			switch intervals[ecosystem] {
+			case "daily", "weekly", "monthly":
+				// valid
+			default:
+				return fmt.Errorf("invalid schedule_intervals entry for %q: %q (must be \"daily\", \"weekly\" or \"monthly\")",
+					ecosystem, intervals[ecosystem])
+			}
+		}
+	}
+	if cfg.Checks.Settings != nil {
+		for _, pattern := range cfg.Checks.Settings.HomepageAllowedHosts {
+			if _, err := glob.Compile(pattern, '.'); err != nil {
+				return fmt.Errorf("invalid homepage_allowed_hosts pattern %q: %w", pattern, err)
+			}
+		}
+		if slices.Contains(cfg.Checks.Settings.DiscussionCategories, "") {
+			return errors.New("discussion_categories must not contain empty names")
+		}
+	}
+	if cfg.Checks.Settings != nil && cfg.Checks.Settings.MaxSizeKB != nil && *cfg.Checks.Settings.MaxSizeKB <= 0 {
+		return fmt.Errorf("invalid max_size_kb: %d (must be greater than 0)", *cfg.Checks.Settings.MaxSizeKB)
+	}
+	if cfg.Checks.Branches != nil && cfg.Checks.Branches.StaleAfterDays != nil && *cfg.Checks.Branches.StaleAfterDays <= 0 {
+		return fmt.Errorf("invalid stale_after_days: %d (must be greater than 0)", *cfg.Checks.Branches.StaleAfterDays)
+	}
+	if cfg.Checks.Branches != nil {
+		for _, pattern := range cfg.Checks.Branches.ForbiddenBranches {
+			if _, err := glob.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid forbidden_branches pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	if cfg.Checks.Topics != nil {
+		for _, pattern := range cfg.Checks.Topics.AllowedTopics {
+			if _, err := glob.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid allowed_topics pattern %q: %w", pattern, err)
+			}
+		}
+	}
+	if br := cfg.Checks.BranchRules; br != nil {
+		if br.RequiredApprovals != nil && (*br.RequiredApprovals < 0 || *br.RequiredApprovals > 10) {
+			return fmt.Errorf("invalid required_approvals: %d (must be between 0 and 10)", *br.RequiredApprovals)
+		}
+		if slices.Contains(br.RequireStatusChecks, "") {
+			return errors.New("require_status_checks must not contain empty contexts")
+		}
+	}
+	if cfg.Checks.TemplateStructure != nil {
+		for _, form := range cfg.Checks.TemplateStructure.IssueForms {
+			if _, err := glob.Compile(form.Forms); form.Forms != "" && err != nil {
+				return fmt.Errorf("invalid issue_forms pattern %q: %w", form.Forms, err)
+			}
+			if len(form.RequiredFields) == 0 {
+				return errors.New("issue_forms entries must have required_fields")
+			}
+		}
+	}
+	if cfg.Checks.Readme != nil {
+		for _, section := range cfg.Checks.Readme.RequiredSections {
+			if strings.TrimSpace(strings.TrimLeft(section, "#")) == "" {
+				return errors.New("readme required_sections must not contain empty sections")
+			}
+		}
+	}
+	if cfg.Checks.CheckRuns != nil {
+		for _, run := range cfg.Checks.CheckRuns.Required {
+			if strings.TrimSpace(run.Name) == "" {
+				return errors.New("check_runs required entries require name")
+			}
+		}
+	}
+	if languages := cfg.Checks.Languages; languages != nil && languages.ForbiddenThresholdPercent != nil {
+		if pct := *languages.ForbiddenThresholdPercent; pct < 0 || pct >= 100 {
+			return fmt.Errorf("invalid forbidden_threshold_percent: %d (must be between 0 and 99)", pct)
+		}
+	}
+	if actions := cfg.Checks.Actions; actions != nil {
+		for _, pattern := range actions.OIDCJobs {
+			if _, err := glob.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid oidc_jobs pattern %q: %w", pattern, err)
+			}
+		}
+		if actions.MaxScheduledWorkflows != nil && *actions.MaxScheduledWorkflows < 0 {
+			return fmt.Errorf("invalid max_scheduled_workflows: %d (must be 0 or greater)", *actions.MaxScheduledWorkflows)
+		}
+		if actions.MaxStepTimeoutMinutes != nil && *actions.MaxStepTimeoutMinutes <= 0 {
+			return fmt.Errorf("invalid max_step_timeout_minutes: %d (must be greater than 0)", *actions.MaxStepTimeoutMinutes)
+		}
+		if actions.RequireStepTimeoutAboveMinutes != nil && *actions.RequireStepTimeoutAboveMinutes < 0 {
+			return fmt.Errorf("invalid require_step_timeout_above_minutes: %d (must be 0 or greater)", *actions.RequireStepTimeoutAboveMinutes)
+		}
+		if actions.MinScheduleIntervalMinutes != nil && *actions.MinScheduleIntervalMinutes <= 0 {
+			return fmt.Errorf("invalid min_schedule_interval_minutes: %d (must be greater than 0)", *actions.MinScheduleIntervalMinutes)
+		}
+		for _, wf := range actions.RequiredWorkflows {
+			for _, job := range wf.RequiredJobs {
+				if strings.TrimSpace(job) == "" {
+					return fmt.Errorf("empty required_jobs entry for workflow %q", wf.Path)
+				}
+			}
+		}
+		for _, pattern := range actions.RequireDependencyCache {
+			if _, err := glob.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid require_dependency_cache pattern %q: %w", pattern, err)
+			}
+		}
+		for _, rule := range actions.CheckoutFetchDepth {
+			if rule.Workflows == "" {
+				return errors.New("checkout_fetch_depth entries require workflows")
+			}
+			if _, err := glob.Compile(rule.Workflows); err != nil {
+				return fmt.Errorf("invalid checkout_fetch_depth workflows pattern %q: %w", rule.Workflows, err)
+			}
+			if rule.FetchDepth < 0 {
+				return fmt.Errorf("invalid checkout_fetch_depth fetch_depth: %d (must not be negative)", rule.FetchDepth)
+			}
+		}
+		for _, entry := range actions.PullRequestTargetAllowlist {
+			if entry.Workflow == "" {
+				return errors.New("pull_request_target_allowlist entries require workflow")
+			}
+			if entry.Justification == "" {
+				return fmt.Errorf("pull_request_target_allowlist entry %q missing required justification field", entry.Workflow)
+			}
+		}
+	}
+	return nil
+}
+
+// validateMergeQueue checks the merge queue enums and that entry counts and timeouts are positive
+func validateMergeQueue(mq *MergeQueueConfig) error {
+	switch mq.MergeMethod {
+	case "", "MERGE", "SQUASH", "REBASE":
+	default:
+		return fmt.Errorf("invalid merge_queue merge_method: %q (must be \"MERGE\", \"SQUASH\" or \"REBASE\")", mq.MergeMethod)
+	}
+	switch mq.GroupingStrategy {
+	case "", "ALLGREEN", "HEADGREEN":
+	default:
+		return fmt.Errorf("invalid merge_queue grouping_strategy: %q (must be \"ALLGREEN\" or \"HEADGREEN\")", mq.GroupingStrategy)
+	}
+	positive := []struct {
+		name  string
+		value *int
+	}{
+		{"max_entries_to_build", mq.MaxEntriesToBuild},
+		{"max_entries_to_merge", mq.MaxEntriesToMerge},
+		{"min_entries_to_merge", mq.MinEntriesToMerge},
+		{"check_response_timeout_minutes", mq.CheckResponseTimeoutMinutes},
+	}
+	for _, f := range positive {
+		if f.value != nil && *f.value <= 0 {
+			return fmt.Errorf("invalid merge_queue %s: %d (must be greater than 0)", f.name, *f.value)
+		}
+	}
+	if mq.MinEntriesToMergeWaitMinutes != nil && *mq.MinEntriesToMergeWaitMinutes < 0 {
+		return fmt.Errorf("invalid merge_queue min_entries_to_merge_wait_minutes: %d (must not be negative)", *mq.MinEntriesToMergeWaitMinutes)
+	}
 	return nil
 }
 
@@ -1,5 +1,7 @@
 package config
 
+import "maps"
+
 // MergeConfigs merges owner and repo configs.
 // Repo config takes precedence over owner config.
 // Rules:
@@ -18,17 +20,49 @@ func MergeConfigs(owner, repo *Config) *Config {
 	}
 
 	result := &Config{
+		Base: mergeString(owner.Base, repo.Base),
 		Checks: ChecksConfig{
-			Settings: mergeSettingsConfig(owner.Checks.Settings, repo.Checks.Settings),
-			Actions:  mergeActionsConfig(owner.Checks.Actions, repo.Checks.Actions),
-			Rulesets: mergeRulesets(owner.Checks.Rulesets, repo.Checks.Rulesets),
-			Files:    mergeFiles(owner.Checks.Files, repo.Checks.Files),
+			Settings:          mergeSettingsConfig(owner.Checks.Settings, repo.Checks.Settings),
+			Actions:           mergeActionsConfig(owner.Checks.Actions, repo.Checks.Actions),
+			Rulesets:          mergeRulesets(owner.Checks.Rulesets, repo.Checks.Rulesets),
+			RulesetSet:        mergeRulesetSetConfig(owner.Checks.RulesetSet, repo.Checks.RulesetSet),
+			Files:             mergeFiles(owner.Checks.Files, repo.Checks.Files),
+			Autolinks:         mergeAutolinks(owner.Checks.Autolinks, repo.Checks.Autolinks),
+			Labels:            mergeLabels(owner.Checks.Labels, repo.Checks.Labels),
+			Branches:          mergeBranchesConfig(owner.Checks.Branches, repo.Checks.Branches),
+			Topics:            mergeTopicsConfig(owner.Checks.Topics, repo.Checks.Topics),
+			Languages:         mergeLanguagesConfig(owner.Checks.Languages, repo.Checks.Languages),
+			Funding:           mergeFundingConfig(owner.Checks.Funding, repo.Checks.Funding),
+			Dependabot:        mergeDependabotConfig(owner.Checks.Dependabot, repo.Checks.Dependabot),
+			HelpURLs:          mergeStringMap(owner.Checks.HelpURLs, repo.Checks.HelpURLs),
+			Custom:            mergeCustomChecks(owner.Checks.Custom, repo.Checks.Custom),
+			TemplateStructure: mergeTemplateStructureConfig(owner.Checks.TemplateStructure, repo.Checks.TemplateStructure),
+			Readme:            mergeReadmeConfig(owner.Checks.Readme, repo.Checks.Readme),
+			CheckRuns:         mergeCheckRunsConfig(owner.Checks.CheckRuns, repo.Checks.CheckRuns),
+			// Default branch protection, declared without a reference ruleset
+			BranchRules: mergeBranchRulesConfig(owner.Checks.BranchRules, repo.Checks.BranchRules),
+			// Organization settings are only read from the owner config; a repository cannot override them
+			Organization: owner.Checks.Organization,
 		},
 	}
 
 	return result
 }
 
+// mergeStringMap shallow-merges two maps, with repo keys overriding owner keys
+func mergeStringMap(owner, repo map[string]string) map[string]string {
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	result := maps.Clone(owner)
+	maps.Copy(result, repo)
+	return result
+}
+
 func mergeSettingsConfig(owner, repo *SettingsConfig) *SettingsConfig {
 	if owner == nil && repo == nil {
 		return nil
@@ -45,16 +79,42 @@ func mergeSettingsConfig(owner, repo *SettingsConfig) *SettingsConfig {
 		Wiki:                      mergeBoolPtr(owner.Wiki, repo.Wiki),
 		Projects:                  mergeBoolPtr(owner.Projects, repo.Projects),
 		Discussions:               mergeBoolPtr(owner.Discussions, repo.Discussions),
+		Downloads:                 mergeBoolPtr(owner.Downloads, repo.Downloads),
+		AllowForking:              mergeBoolPtr(owner.AllowForking, repo.AllowForking),
+		WebCommitSignoffRequired:  mergeBoolPtr(owner.WebCommitSignoffRequired, repo.WebCommitSignoffRequired),
 		AllowActionsToApprovePRs:  mergeBoolPtr(owner.AllowActionsToApprovePRs, repo.AllowActionsToApprovePRs),
 		PullRequestCreationPolicy: mergeString(owner.PullRequestCreationPolicy, repo.PullRequestCreationPolicy),
 		DefaultBranch:             mergeString(owner.DefaultBranch, repo.DefaultBranch),
 		Merge:                     mergeMergeConfig(owner.Merge, repo.Merge),
+		MergeQueue:                mergeMergeQueueConfig(owner.MergeQueue, repo.MergeQueue),
 		Dependabot:                mergeDependabotSettingsConfig(owner.Dependabot, repo.Dependabot),
+		MaxSizeKB:                 mergeIntPtr(owner.MaxSizeKB, repo.MaxSizeKB),
+		AdvancedSecurity:          mergeBoolPtr(owner.AdvancedSecurity, repo.AdvancedSecurity),
+		SecretScanningNonProvider: mergeBoolPtr(owner.SecretScanningNonProvider, repo.SecretScanningNonProvider),
+		MergeTiers:                mergeMergeTiersConfig(owner.MergeTiers, repo.MergeTiers),
+		HomepageAllowedHosts:      owner.HomepageAllowedHosts,
+		DiscussionCategories:      owner.DiscussionCategories,
+	}
+
+	// Arrays: repo replaces entirely
+	if repo.HomepageAllowedHosts != nil {
+		result.HomepageAllowedHosts = repo.HomepageAllowedHosts
+	}
+	if repo.DiscussionCategories != nil {
+		result.DiscussionCategories = repo.DiscussionCategories
 	}
 
 	return result
 }
 
+func mergeMergeTiersConfig(owner, repo *MergeTiersConfig) *MergeTiersConfig {
+	// The property and matrix go together, so the repo's replaces the owner's
+	if repo != nil {
+		return repo
+	}
+	return owner
+}
+
 func mergeMergeConfig(owner, repo *MergeConfig) *MergeConfig {
 	if owner == nil && repo == nil {
 		return nil
@@ -73,6 +133,29 @@ func mergeMergeConfig(owner, repo *MergeConfig) *MergeConfig {
 		AllowAutoMerge:                           mergeBoolPtr(owner.AllowAutoMerge, repo.AllowAutoMerge),
 		DeleteBranchOnMerge:                      mergeBoolPtr(owner.DeleteBranchOnMerge, repo.DeleteBranchOnMerge),
 		AlwaysSuggestUpdatingPullRequestBranches: mergeBoolPtr(owner.AlwaysSuggestUpdatingPullRequestBranches, repo.AlwaysSuggestUpdatingPullRequestBranches),
+		SquashMergeCommitMessage:                 mergeString(owner.SquashMergeCommitMessage, repo.SquashMergeCommitMessage),
+	}
+}
+
+func mergeMergeQueueConfig(owner, repo *MergeQueueConfig) *MergeQueueConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	return &MergeQueueConfig{
+		MergeMethod:                  mergeString(owner.MergeMethod, repo.MergeMethod),
+		GroupingStrategy:             mergeString(owner.GroupingStrategy, repo.GroupingStrategy),
+		MaxEntriesToBuild:            mergeIntPtr(owner.MaxEntriesToBuild, repo.MaxEntriesToBuild),
+		MaxEntriesToMerge:            mergeIntPtr(owner.MaxEntriesToMerge, repo.MaxEntriesToMerge),
+		MinEntriesToMerge:            mergeIntPtr(owner.MinEntriesToMerge, repo.MinEntriesToMerge),
+		MinEntriesToMergeWaitMinutes: mergeIntPtr(owner.MinEntriesToMergeWaitMinutes, repo.MinEntriesToMergeWaitMinutes),
+		CheckResponseTimeoutMinutes:  mergeIntPtr(owner.CheckResponseTimeoutMinutes, repo.CheckResponseTimeoutMinutes),
 	}
 }
 
@@ -88,10 +171,25 @@ func mergeActionsConfig(owner, repo *ActionsConfig) *ActionsConfig {
 	}
 
 	result := &ActionsConfig{
-		RequirePinnedVersions:     mergeBoolPtr(owner.RequirePinnedVersions, repo.RequirePinnedVersions),
-		RequireTimeout:            mergeBoolPtr(owner.RequireTimeout, repo.RequireTimeout),
-		MaxTimeoutMinutes:         mergeIntPtr(owner.MaxTimeoutMinutes, repo.MaxTimeoutMinutes),
-		RequireMinimalPermissions: mergeBoolPtr(owner.RequireMinimalPermissions, repo.RequireMinimalPermissions),
+		RequirePinnedVersions:          mergeBoolPtr(owner.RequirePinnedVersions, repo.RequirePinnedVersions),
+		RequireTimeout:                 mergeBoolPtr(owner.RequireTimeout, repo.RequireTimeout),
+		MaxTimeoutMinutes:              mergeIntPtr(owner.MaxTimeoutMinutes, repo.MaxTimeoutMinutes),
+		MaxStepTimeoutMinutes:          mergeIntPtr(owner.MaxStepTimeoutMinutes, repo.MaxStepTimeoutMinutes),
+		RequireStepTimeoutAboveMinutes: mergeIntPtr(owner.RequireStepTimeoutAboveMinutes, repo.RequireStepTimeoutAboveMinutes),
+		RequireMinimalPermissions:      mergeBoolPtr(owner.RequireMinimalPermissions, repo.RequireMinimalPermissions),
+		RequireWorkflowName:            mergeBoolPtr(owner.RequireWorkflowName, repo.RequireWorkflowName),
+		MaxScheduledWorkflows:          mergeIntPtr(owner.MaxScheduledWorkflows, repo.MaxScheduledWorkflows),
+		MinScheduleIntervalMinutes:     mergeIntPtr(owner.MinScheduleIntervalMinutes, repo.MinScheduleIntervalMinutes),
+		ForbidHardcodedSecrets:         mergeBoolPtr(owner.ForbidHardcodedSecrets, repo.ForbidHardcodedSecrets),
+		RequireScheduleDispatch:        mergeBoolPtr(owner.RequireScheduleDispatch, repo.RequireScheduleDispatch),
+		ForbidPullRequestSecretEnv:     mergeBoolPtr(owner.ForbidPullRequestSecretEnv, repo.ForbidPullRequestSecretEnv),
+		DetectUnusedWritePermissions:   mergeBoolPtr(owner.DetectUnusedWritePermissions, repo.DetectUnusedWritePermissions),
+		ForbidLatestRunners:            mergeBoolPtr(owner.ForbidLatestRunners, repo.ForbidLatestRunners),
+		RequireProtectedEnvironments:   mergeBoolPtr(owner.RequireProtectedEnvironments, repo.RequireProtectedEnvironments),
+		RequireActiveWorkflows:         mergeBoolPtr(owner.RequireActiveWorkflows, repo.RequireActiveWorkflows),
+		ValidateReusableWorkflowInputs: mergeBoolPtr(owner.ValidateReusableWorkflowInputs, repo.ValidateReusableWorkflowInputs),
+		ForbidDeprecatedCommands:       mergeBoolPtr(owner.ForbidDeprecatedCommands, repo.ForbidDeprecatedCommands),
+		RestrictPullRequestTarget:      mergeBoolPtr(owner.RestrictPullRequestTarget, repo.RestrictPullRequestTarget),
 	}
 
 	// Arrays: repo replaces entirely
@@ -100,10 +198,43 @@ func mergeActionsConfig(owner, repo *ActionsConfig) *ActionsConfig {
 	} else {
 		result.RequiredWorkflows = owner.RequiredWorkflows
 	}
+	if repo.RequireDependencyCache != nil {
+		result.RequireDependencyCache = repo.RequireDependencyCache
+	} else {
+		result.RequireDependencyCache = owner.RequireDependencyCache
+	}
+	if repo.PinnedRunners != nil {
+		result.PinnedRunners = repo.PinnedRunners
+	} else {
+		result.PinnedRunners = owner.PinnedRunners
+	}
+	if repo.CheckoutFetchDepth != nil {
+		result.CheckoutFetchDepth = repo.CheckoutFetchDepth
+	} else {
+		result.CheckoutFetchDepth = owner.CheckoutFetchDepth
+	}
+	if repo.OIDCJobs != nil {
+		result.OIDCJobs = repo.OIDCJobs
+	} else {
+		result.OIDCJobs = owner.OIDCJobs
+	}
+	if repo.PullRequestTargetAllowlist != nil {
+		result.PullRequestTargetAllowlist = repo.PullRequestTargetAllowlist
+	} else {
+		result.PullRequestTargetAllowlist = owner.PullRequestTargetAllowlist
+	}
 
 	return result
 }
 
+func mergeRulesetSetConfig(owner, repo *RulesetSetConfig) *RulesetSetConfig {
+	// The set is managed as a whole, so the repo's set replaces the owner's
+	if repo != nil {
+		return repo
+	}
+	return owner
+}
+
 func mergeRulesets(owner, repo []RulesetConfig) []RulesetConfig {
 	// Arrays: repo replaces entirely
 	if repo != nil {
@@ -120,6 +251,271 @@ func mergeFiles(owner, repo []FileConfig) []FileConfig {
 	return owner
 }
 
+func mergeAutolinks(owner, repo []AutolinkConfig) []AutolinkConfig {
+	// Arrays: repo replaces entirely
+	if repo != nil {
+		return repo
+	}
+	return owner
+}
+
+func mergeLabels(owner, repo []LabelConfig) []LabelConfig {
+	// Arrays: repo replaces entirely
+	if repo != nil {
+		return repo
+	}
+	return owner
+}
+
+func mergeCustomChecks(owner, repo []CustomCheckConfig) []CustomCheckConfig {
+	// Arrays: repo replaces entirely
+	if repo != nil {
+		return repo
+	}
+	return owner
+}
+
+func mergeTopicsConfig(owner, repo *TopicsConfig) *TopicsConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	// Arrays: repo replaces entirely
+	result := &TopicsConfig{
+		RequiredTopics: owner.RequiredTopics,
+		AllowedTopics:  owner.AllowedTopics,
+	}
+	if repo.RequiredTopics != nil {
+		result.RequiredTopics = repo.RequiredTopics
+	}
+	if repo.AllowedTopics != nil {
+		result.AllowedTopics = repo.AllowedTopics
+	}
+
+	return result
+}
+
+func mergeLanguagesConfig(owner, repo *LanguagesConfig) *LanguagesConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	result := &LanguagesConfig{
+		Primary:                   mergeString(owner.Primary, repo.Primary),
+		Forbidden:                 owner.Forbidden,
+		ForbiddenThresholdPercent: mergeIntPtr(owner.ForbiddenThresholdPercent, repo.ForbiddenThresholdPercent),
+	}
+	// Arrays: repo replaces entirely
+	if repo.Forbidden != nil {
+		result.Forbidden = repo.Forbidden
+	}
+
+	return result
+}
+
+func mergeTemplateStructureConfig(owner, repo *TemplateStructureConfig) *TemplateStructureConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	// Arrays: repo replaces entirely
+	result := &TemplateStructureConfig{
+		PullRequestHeadings: owner.PullRequestHeadings,
+		IssueForms:          owner.IssueForms,
+	}
+	if repo.PullRequestHeadings != nil {
+		result.PullRequestHeadings = repo.PullRequestHeadings
+	}
+	if repo.IssueForms != nil {
+		result.IssueForms = repo.IssueForms
+	}
+
+	return result
+}
+
+func mergeReadmeConfig(owner, repo *ReadmeConfig) *ReadmeConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	// Arrays: repo replaces entirely
+	result := &ReadmeConfig{
+		RequiredSections: owner.RequiredSections,
+	}
+	if repo.RequiredSections != nil {
+		result.RequiredSections = repo.RequiredSections
+	}
+
+	return result
+}
+
+func mergeCheckRunsConfig(owner, repo *CheckRunsConfig) *CheckRunsConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	// Arrays: repo replaces entirely
+	result := &CheckRunsConfig{
+		Required: owner.Required,
+	}
+	if repo.Required != nil {
+		result.Required = repo.Required
+	}
+
+	return result
+}
+
+func mergeBranchRulesConfig(owner, repo *BranchRulesConfig) *BranchRulesConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	result := &BranchRulesConfig{
+		RulesetName:          mergeString(owner.RulesetName, repo.RulesetName),
+		RequirePullRequest:   mergeBoolPtr(owner.RequirePullRequest, repo.RequirePullRequest),
+		RequiredApprovals:    mergeIntPtr(owner.RequiredApprovals, repo.RequiredApprovals),
+		RequireLinearHistory: mergeBoolPtr(owner.RequireLinearHistory, repo.RequireLinearHistory),
+		RequireStatusChecks:  owner.RequireStatusChecks,
+	}
+	// Arrays: repo replaces entirely
+	if repo.RequireStatusChecks != nil {
+		result.RequireStatusChecks = repo.RequireStatusChecks
+	}
+
+	return result
+}
+
+func mergeBranchesConfig(owner, repo *BranchesConfig) *BranchesConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	result := &BranchesConfig{
+		StaleAfterDays: mergeIntPtr(owner.StaleAfterDays, repo.StaleAfterDays),
+	}
+
+	// Arrays: repo replaces entirely
+	if repo.ForbiddenBranches != nil {
+		result.ForbiddenBranches = repo.ForbiddenBranches
+	} else {
+		result.ForbiddenBranches = owner.ForbiddenBranches
+	}
+
+	return result
+}
+
+func mergeDependabotConfig(owner, repo *DependabotConfig) *DependabotConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	return &DependabotConfig{
+		RequireOpenPullRequestsLimit: mergeBoolPtr(owner.RequireOpenPullRequestsLimit, repo.RequireOpenPullRequestsLimit),
+		MaxOpenPullRequestsLimit:     mergeIntPtr(owner.MaxOpenPullRequestsLimit, repo.MaxOpenPullRequestsLimit),
+		TargetBranch:                 mergeDependabotTargetBranchConfig(owner.TargetBranch, repo.TargetBranch),
+		ScheduleIntervals:            mergeStringMap(owner.ScheduleIntervals, repo.ScheduleIntervals),
+	}
+}
+
+func mergeDependabotTargetBranchConfig(owner, repo *DependabotTargetBranchConfig) *DependabotTargetBranchConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	result := &DependabotTargetBranchConfig{
+		Branch:     mergeString(owner.Branch, repo.Branch),
+		Ecosystems: owner.Ecosystems,
+	}
+
+	// Arrays: repo replaces entirely
+	if repo.Ecosystems != nil {
+		result.Ecosystems = repo.Ecosystems
+	}
+
+	return result
+}
+
+func mergeFundingConfig(owner, repo *FundingConfig) *FundingConfig {
+	if owner == nil && repo == nil {
+		return nil
+	}
+	if owner == nil {
+		return repo
+	}
+	if repo == nil {
+		return owner
+	}
+
+	result := &FundingConfig{
+		GitHub: owner.GitHub,
+		Custom: owner.Custom,
+	}
+
+	// Arrays: repo replaces entirely
+	if repo.GitHub != nil {
+		result.GitHub = repo.GitHub
+	}
+	if repo.Custom != nil {
+		result.Custom = repo.Custom
+	}
+
+	return result
+}
+
 func mergeDependabotSettingsConfig(owner, repo *DependabotSettingsConfig) *DependabotSettingsConfig {
 	if owner == nil && repo == nil {
 		return nil
@@ -134,6 +530,7 @@ func mergeDependabotSettingsConfig(owner, repo *DependabotSettingsConfig) *Depen
 	return &DependabotSettingsConfig{
 		Alerts:          mergeBoolPtr(owner.Alerts, repo.Alerts),
 		SecurityUpdates: mergeBoolPtr(owner.SecurityUpdates, repo.SecurityUpdates),
+		DependencyGraph: mergeBoolPtr(owner.DependencyGraph, repo.DependencyGraph),
 	}
 }
 
@@ -0,0 +1,128 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// DefaultReferenceConcurrency is the number of references resolved in parallel by ValidateReferences
+const DefaultReferenceConcurrency = 8
+
+// Reference is a single reference declared in the configuration
+type Reference struct {
+	// Path identifies where the reference is declared (e.g. "rulesets[main]")
+	Path  string
+	Value string
+}
+
+// ReferenceResult is the outcome of resolving a single reference
+type ReferenceResult struct {
+	Reference
+	Err error
+}
+
+// CollectReferences returns every reference declared in the config, in config order
+func CollectReferences(cfg *Config) []Reference {
+	var refs []Reference
+
+	if cfg.Checks.Settings != nil && cfg.Checks.Settings.MergeTiers != nil {
+		refs = append(refs, Reference{
+			Path:  "settings.merge_tiers",
+			Value: cfg.Checks.Settings.MergeTiers.Reference,
+		})
+	}
+
+	if cfg.Checks.Actions != nil {
+		for _, wf := range cfg.Checks.Actions.RequiredWorkflows {
+			if wf.Reference != "" {
+				refs = append(refs, Reference{
+					Path:  fmt.Sprintf("actions.required_workflows[%s]", wf.Path),
+					Value: wf.Reference,
+				})
+			}
+		}
+		for _, entry := range cfg.Checks.Actions.PullRequestTargetAllowlist {
+			refs = append(refs, Reference{
+				Path:  fmt.Sprintf("actions.pull_request_target_allowlist[%s]", entry.Workflow),
+				Value: entry.Justification,
+			})
+		}
+	}
+
+	for _, rs := range cfg.Checks.Rulesets {
+		if rs.Reference != "" {
+			refs = append(refs, Reference{
+				Path:  fmt.Sprintf("rulesets[%s]", rs.Name),
+				Value: rs.Reference,
+			})
+		}
+		if rs.BypassExpiries != "" {
+			refs = append(refs, Reference{
+				Path:  fmt.Sprintf("rulesets[%s].bypass_expiries", rs.Name),
+				Value: rs.BypassExpiries,
+			})
+		}
+	}
+
+	for _, f := range cfg.Checks.Files {
+		if f.Reference != "" {
+			refs = append(refs, Reference{
+				Path:  fmt.Sprintf("files[%s]", f.Name),
+				Value: f.Reference,
+			})
+		}
+		for _, language := range slices.Sorted(maps.Keys(f.LanguageReferences)) {
+			refs = append(refs, Reference{
+				Path:  fmt.Sprintf("files[%s].language_references[%s]", f.Name, language),
+				Value: f.LanguageReferences[language],
+			})
+		}
+	}
+
+	return refs
+}
+
+// ValidateReferences resolves refs concurrently with the validator, using at most
+// concurrency workers. Results are returned in the same order as refs.
+func ValidateReferences(refs []Reference, validator ReferenceValidator, concurrency int) []ReferenceResult {
+	if concurrency <= 0 {
+		concurrency = DefaultReferenceConcurrency
+	}
+
+	results := make([]ReferenceResult, len(refs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, ref := range refs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = ReferenceResult{Reference: ref, Err: validator(ref.Value)}
+		}()
+	}
+
+	wg.Wait()
+	return results
+}
+
+// PrevalidateReferences resolves every reference in cfg concurrently, using at most
+// concurrency workers, and returns a validator that answers from those results. This
+// lets DisplayConfig print in config order without waiting on each reference in turn.
+// References that were not collected fall through to validator.
+func PrevalidateReferences(cfg *Config, validator ReferenceValidator, concurrency int) ReferenceValidator {
+	errs := make(map[string]error)
+	for _, result := range ValidateReferences(CollectReferences(cfg), validator, concurrency) {
+		errs[result.Value] = result.Err
+	}
+
+	return func(reference string) error {
+		if err, ok := errs[reference]; ok {
+			return err
+		}
+		return validator(reference)
+	}
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPrevalidateReferences(t *testing.T) {
+	cfg := &Config{Checks: ChecksConfig{
+		Rulesets: []RulesetConfig{{Name: "main", Reference: "me/me/ruleset.json"}},
+		Files: []FileConfig{
+			{Name: ".editorconfig", Reference: "me/me/.editorconfig"},
+			{Name: "LICENSE", Reference: "me/me/missing"},
+		},
+	}}
+
+	var calls atomic.Int32
+	errMissing := errors.New("not found")
+	validator := PrevalidateReferences(cfg, func(reference string) error {
+		calls.Add(1)
+		if reference == "me/me/missing" {
+			return errMissing
+		}
+		return nil
+	}, 2)
+
+	if got := calls.Load(); got != 3 {
+		t.Fatalf("validator called %d times while prevalidating, want 3", got)
+	}
+	if err := validator("me/me/ruleset.json"); err != nil {
+		t.Errorf("validator(ruleset) = %v, want nil", err)
+	}
+	if err := validator("me/me/missing"); !errors.Is(err, errMissing) {
+		t.Errorf("validator(missing) = %v, want %v", err, errMissing)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("validator called %d times after lookups, want 3 (results should be reused)", got)
+	}
+
+	_ = validator("me/me/other")
+	if got := calls.Load(); got != 4 {
+		t.Errorf("validator called %d times for an uncollected reference, want 4", got)
+	}
+}
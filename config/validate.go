@@ -0,0 +1,111 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// ValidationWarning describes a combination of settings in the effective config
+// that is valid on its own but likely not what was intended
+type ValidationWarning struct {
+	Path    string // Config path of the offending setting (e.g. "checks.settings.merge")
+	Message string
+}
+
+func (w ValidationWarning) String() string {
+	return fmt.Sprintf("%s: %s", w.Path, w.Message)
+}
+
+// Validate checks the effective (merged) config for cross-setting problems that
+// cannot be detected one field at a time by the parser
+func Validate(cfg *Config) []ValidationWarning {
+	var warnings []ValidationWarning
+
+	if cfg.Checks.Settings != nil && cfg.Checks.Settings.Merge != nil {
+		warnings = append(warnings, validateMergePolicy(cfg.Checks.Settings.Merge)...)
+	}
+
+	if settings := cfg.Checks.Settings; settings != nil && settings.Dependabot != nil {
+		if isTrue(settings.Dependabot.Alerts) && isFalse(settings.Dependabot.DependencyGraph) {
+			warnings = append(warnings, ValidationWarning{
+				Path:    "checks.settings.dependabot.dependency_graph",
+				Message: "Dependabot alerts require the dependency graph, so alerts will not be raised while it is disabled",
+			})
+		}
+	}
+
+	if actions := cfg.Checks.Actions; actions != nil {
+		if !isTrue(actions.RequireTimeout) && actions.MaxTimeoutMinutes != nil {
+			warnings = append(warnings, ValidationWarning{
+				Path:    "checks.actions.max_timeout_minutes",
+				Message: "max_timeout_minutes is ignored unless require_timeout is true",
+			})
+		}
+	}
+
+	for _, f := range cfg.Checks.Files {
+		if f.Reference == "" && len(f.LanguageReferences) == 0 {
+			warnings = append(warnings, ValidationWarning{
+				Path:    fmt.Sprintf("checks.files[%s]", f.Name),
+				Message: "file has no reference, so its content cannot be validated",
+			})
+			continue
+		}
+		if isTrue(f.DetectDrift) {
+			if remote, err := github.ParseRemoteReference(f.Reference); err != nil || remote.Ref == "" {
+				warnings = append(warnings, ValidationWarning{
+					Path:    fmt.Sprintf("checks.files[%s].detect_drift", f.Name),
+					Message: "detect_drift requires a remote reference pinned to a ref (owner/repo/path@ref)",
+				})
+			}
+		}
+	}
+
+	return warnings
+}
+
+// ErrNoMergeMethod is returned for a merge configuration that disables every merge
+// method, which GitHub rejects since pull requests could not be merged at all
+var ErrNoMergeMethod = errors.New("at least one merge method must be enabled")
+
+// validateMergeMethods rejects a config where every merge method is disabled
+func validateMergeMethods(cfg *Config) error {
+	if cfg.Checks.Settings == nil || cfg.Checks.Settings.Merge == nil {
+		return nil
+	}
+	merge := cfg.Checks.Settings.Merge
+	if isFalse(merge.AllowMergeCommit) && isFalse(merge.AllowSquashMerge) && isFalse(merge.AllowRebaseMerge) {
+		return fmt.Errorf("invalid checks.settings.merge: allow_merge_commit, allow_squash_merge and allow_rebase_merge are all false: %w", ErrNoMergeMethod)
+	}
+	return nil
+}
+
+// validateMergePolicy warns when squash is the only merge method but squash commits
+// would not preserve the authors of the squashed commits. The squash commit is
+// authored by the PR author; only COMMIT_MESSAGES keeps the Co-authored-by trailers.
+func validateMergePolicy(merge *MergeConfig) []ValidationWarning {
+	squashOnly := isTrue(merge.AllowSquashMerge) && isFalse(merge.AllowMergeCommit) && isFalse(merge.AllowRebaseMerge)
+	if !squashOnly || merge.SquashMergeCommitMessage == "COMMIT_MESSAGES" {
+		return nil
+	}
+
+	message := "squash merge is the only allowed merge method, so commits are attributed to the PR author"
+	if merge.SquashMergeCommitMessage == "" {
+		message += "; set squash_merge_commit_message to \"COMMIT_MESSAGES\" to preserve co-author attribution"
+	} else {
+		message += fmt.Sprintf("; squash_merge_commit_message %q drops co-author attribution (use \"COMMIT_MESSAGES\")",
+			merge.SquashMergeCommitMessage)
+	}
+
+	return []ValidationWarning{{Path: "checks.settings.merge", Message: message}}
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
+
+func isFalse(b *bool) bool {
+	return b != nil && !*b
+}
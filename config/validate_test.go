@@ -0,0 +1,116 @@
+package config
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	yes, no := true, false
+	timeout := 30
+
+	tests := []struct {
+		name string
+		cfg  Config
+		want int
+	}{
+		{
+			name: "empty config",
+			cfg:  Config{},
+			want: 0,
+		},
+		{
+			name: "squash only without commit messages",
+			cfg: Config{Checks: ChecksConfig{Settings: &SettingsConfig{Merge: &MergeConfig{
+				AllowMergeCommit: &no, AllowSquashMerge: &yes, AllowRebaseMerge: &no,
+			}}}},
+			want: 1,
+		},
+		{
+			name: "squash only with commit messages",
+			cfg: Config{Checks: ChecksConfig{Settings: &SettingsConfig{Merge: &MergeConfig{
+				AllowMergeCommit: &no, AllowSquashMerge: &yes, AllowRebaseMerge: &no, SquashMergeCommitMessage: "COMMIT_MESSAGES",
+			}}}},
+			want: 0,
+		},
+		{
+			name: "max timeout without require timeout",
+			cfg: Config{Checks: ChecksConfig{Actions: &ActionsConfig{
+				RequireTimeout: &no, MaxTimeoutMinutes: &timeout,
+			}}},
+			want: 1,
+		},
+		{
+			name: "max timeout with require timeout unset",
+			cfg:  Config{Checks: ChecksConfig{Actions: &ActionsConfig{MaxTimeoutMinutes: &timeout}}},
+			want: 1,
+		},
+		{
+			name: "max timeout with require timeout",
+			cfg: Config{Checks: ChecksConfig{Actions: &ActionsConfig{
+				RequireTimeout: &yes, MaxTimeoutMinutes: &timeout,
+			}}},
+			want: 0,
+		},
+		{
+			name: "file without reference",
+			cfg:  Config{Checks: ChecksConfig{Files: []FileConfig{{Name: ".editorconfig"}}}},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(&tt.cfg)
+			if len(got) != tt.want {
+				t.Errorf("Validate() returned %d warning(s), want %d: %v", len(got), tt.want, got)
+			}
+		})
+	}
+}
+
+func TestValidateMergeMethods(t *testing.T) {
+	yes, no := true, false
+
+	tests := []struct {
+		name    string
+		merge   *MergeConfig
+		wantErr bool
+	}{
+		{name: "no merge config", merge: nil},
+		{name: "squash enabled", merge: &MergeConfig{AllowMergeCommit: &no, AllowSquashMerge: &yes, AllowRebaseMerge: &no}},
+		{name: "unset methods keep repository values", merge: &MergeConfig{AllowMergeCommit: &no, AllowRebaseMerge: &no}},
+		{name: "all disabled", merge: &MergeConfig{AllowMergeCommit: &no, AllowSquashMerge: &no, AllowRebaseMerge: &no}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Checks: ChecksConfig{Settings: &SettingsConfig{Merge: tt.merge}}}
+			err := validateMergeMethods(cfg)
+			if tt.wantErr != errors.Is(err, ErrNoMergeMethod) {
+				t.Errorf("validateMergeMethods() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateConfig_HelpURLs(t *testing.T) {
+	tests := []struct {
+		name     string
+		helpURLs map[string]string
+		wantErr  bool
+	}{
+		{name: "no help URLs"},
+		{name: "known check types", helpURLs: map[string]string{"files": "https://example.com/files", "check_runs": "https://example.com/ci"}},
+		{name: "unknown check type", helpURLs: map[string]string{"file": "https://example.com/files"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConfig(&Config{Checks: ChecksConfig{HelpURLs: tt.helpURLs}})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/spf13/cobra"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func newDoctorCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Report which configured checks the current token can run and fix",
+		Long: `Inspect the current token's OAuth scopes and its role on the current repository,
+and report which configured checks it lacks the access to run or fix, before a lint
+run fails midway with a 403. Fine-grained and GitHub App tokens do not report their
+permissions, so for them only the repository role is compared.`,
+		Args: cobra.NoArgs,
+		RunE: runDoctor,
+	}
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	// Get current repository
+	repo, err := repository.Current()
+	if err != nil {
+		return fmt.Errorf("failed to get current repository: %w", err)
+	}
+
+	// Create GitHub client
+	client, err := github.NewClient(repo.Owner, repo.Name, verboseFlag)
+	if err != nil {
+		return fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+	client.SetIncludeArchived(true)
+
+	scopes, scoped, err := client.TokenScopes()
+	if err != nil {
+		return fmt.Errorf("failed to inspect token: %w", err)
+	}
+	if scoped {
+		fmt.Printf("Token: classic, scopes: %s\n", strings.Join(scopes, ", "))
+	} else {
+		fmt.Println("Token: fine-grained or GitHub App (permissions not reported; comparing the repository role only)")
+	}
+
+	ghRepo, err := client.GetRepository()
+	if err != nil {
+		return fmt.Errorf("insufficient permissions to access repository: %w", err)
+	}
+	role := ghRepo.Permissions.Role()
+	private := ghRepo.Visibility != "public"
+	fmt.Printf("Repository: %s (%s), role: %s\n", ghRepo.FullName, ghRepo.Visibility, role)
+	if ghRepo.Archived {
+		fmt.Println("Repository is archived: only read-only checks run, and fixes cannot be applied")
+	}
+
+	// Load configuration
+	loadedConfig, err := loadConfig(client)
+	if err != nil {
+		return fmt.Errorf("configuration error: %w", err)
+	}
+
+	types := checks.NewRunner(client, loadedConfig.Config, verboseFlag).GetCheckTypes()
+	if loadedConfig.Config.Checks.Organization != nil {
+		types = append(types, checks.CheckTypeOrganization)
+	}
+
+	fmt.Println()
+	failing := 0
+	for _, checkType := range types {
+		access := checks.RequiredAccess(checkType)
+		switch {
+		case access.Shortfall(role, scopes, private, false) != "":
+			failing++
+			fmt.Printf("  ✗ %-20s %s → will fail\n", checkType, access.Shortfall(role, scopes, private, false))
+		case access.Shortfall(role, scopes, private, true) != "":
+			fmt.Printf("  ! %-20s %s → fixes will fail\n", checkType, access.Shortfall(role, scopes, private, true))
+		default:
+			fmt.Printf("  ✓ %s\n", checkType)
+		}
+	}
+	if loadedConfig.Config.Checks.Organization != nil {
+		fmt.Println("\nThe organization check also needs the token's user to be an organization owner")
+	}
+
+	if failing > 0 {
+		return fmt.Errorf("%d check(s) cannot run with the current token", failing)
+	}
+	return nil
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/sethrylan/gh-repolint/checks"
 	"github.com/sethrylan/gh-repolint/config"
@@ -34,6 +33,14 @@ func (f *ActionsFixer) Name() string {
 
 // Fix attempts to fix an actions issue
 func (f *ActionsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	// Disabled workflows are enabled in place; their file is already compliant
+	if workflow := issue.Data[checks.DataKeyWorkflow]; workflow != "" {
+		if err := f.client.EnableWorkflow(workflow); err != nil {
+			return failedResult(issue, fmt.Errorf("failed to enable workflow: %w", err))
+		}
+		return successResult(issue)
+	}
+
 	// Get workflow path from issue data
 	workflowPath := issue.Data[checks.DataKeyFileName]
 	if workflowPath == "" {
@@ -53,55 +60,21 @@ func (f *ActionsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, er
 		return failedResult(issue, fmt.Errorf("no reference specified for workflow %s", workflowPath))
 	}
 
-	// Fetch and write the reference content
-	content, err := f.fetchAndInterpolateReference(wfConfig.Reference)
+	// Fetch the reference content (local path, owner/repo/path or a GitHub URL)
+	refContent, err := github.ResolveReferenceFile(wfConfig.Reference, f.client)
 	if err != nil {
 		return failedResult(issue, fmt.Errorf("failed to fetch reference: %w", err))
 	}
 
-	if err := f.client.WriteFile(workflowPath, content); err != nil {
-		return failedResult(issue, fmt.Errorf("failed to write workflow file: %w", err))
-	}
-
-	return successResult(issue)
-}
-
-func (f *ActionsFixer) fetchAndInterpolateReference(reference string) ([]byte, error) {
-	// Parse reference: owner/repo/path
-	parts := strings.SplitN(reference, "/", 3)
-	if len(parts) < 3 {
-		return nil, fmt.Errorf("invalid reference format: %s", reference)
-	}
-
-	refOwner, refRepo, refPath := parts[0], parts[1], parts[2]
-
-	// Fetch reference content
-	content, err := f.client.GetRemoteFileContent(refOwner, refRepo, refPath)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get repository info for template variables
-	repo, err := f.client.GetRepository()
+	// Hydrate reference file with template variables
+	content, err := f.client.HydrateTemplate(refContent)
 	if err != nil {
-		return nil, err
+		return failedResult(issue, fmt.Errorf("failed to hydrate reference template: %w", err))
 	}
 
-	// Interpolate template variables.
-	// Uses simple string replacement instead of Go's text/template to avoid
-	// conflicts with GitHub Actions expression syntax (${{ }}), which uses
-	// similar curly-brace delimiters.
-	tmplData := map[string]string{
-		"owner":          f.client.Owner(),
-		"repo":           f.client.Repo(),
-		"default_branch": repo.DefaultBranch,
-	}
-
-	result := string(content)
-	for key, value := range tmplData {
-		result = strings.ReplaceAll(result, "{{ ."+key+" }}", value)
-		result = strings.ReplaceAll(result, "{{."+key+"}}", value)
+	if err := f.client.WriteFile(workflowPath, content); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to write workflow file: %w", err))
 	}
 
-	return []byte(result), nil
+	return successResult(issue)
 }
@@ -0,0 +1,83 @@
+package fix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// AutolinksFixer fixes autolink reference issues
+type AutolinksFixer struct {
+	client  *github.Client
+	configs []config.AutolinkConfig
+	verbose bool
+}
+
+// NewAutolinksFixer creates a new autolinks fixer
+func NewAutolinksFixer(client *github.Client, cfgs []config.AutolinkConfig, verbose bool) *AutolinksFixer {
+	return &AutolinksFixer{
+		client:  client,
+		configs: cfgs,
+		verbose: verbose,
+	}
+}
+
+// Name returns the fixer name
+func (f *AutolinksFixer) Name() string {
+	return "autolinks"
+}
+
+// Fix attempts to fix an autolink issue
+// Autolinks cannot be updated in place, so a mismatched autolink is deleted and recreated
+func (f *AutolinksFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	keyPrefix := issue.Data[checks.DataKeyKeyPrefix]
+	if keyPrefix == "" {
+		return failedResult(issue, errors.New("issue data missing key_prefix"))
+	}
+
+	// Find the config for this autolink
+	var cfg *config.AutolinkConfig
+	for i := range f.configs {
+		if f.configs[i].KeyPrefix == keyPrefix {
+			cfg = &f.configs[i]
+			break
+		}
+	}
+
+	if cfg == nil {
+		return failedResult(issue, fmt.Errorf("no config found for autolink '%s'", keyPrefix))
+	}
+
+	autolinks, err := f.client.GetAutolinks()
+	if err != nil {
+		return failedResult(issue, fmt.Errorf("failed to fetch autolinks: %w", err))
+	}
+
+	for _, al := range autolinks {
+		if al.KeyPrefix == keyPrefix {
+			if err := f.client.DeleteAutolink(al.ID); err != nil {
+				return failedResult(issue, fmt.Errorf("failed to delete autolink: %w", err))
+			}
+			break
+		}
+	}
+
+	req := &github.AutolinkCreateRequest{
+		KeyPrefix:      cfg.KeyPrefix,
+		URLTemplate:    cfg.URLTemplate,
+		IsAlphanumeric: true, // GitHub's default
+	}
+	if cfg.IsAlphanumeric != nil {
+		req.IsAlphanumeric = *cfg.IsAlphanumeric
+	}
+
+	if err := f.client.CreateAutolink(req); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to create autolink: %w", err))
+	}
+
+	return successResult(issue)
+}
@@ -0,0 +1,44 @@
+package fix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// BranchesFixer fixes branch hygiene issues
+type BranchesFixer struct {
+	client  *github.Client
+	verbose bool
+}
+
+// NewBranchesFixer creates a new branches fixer
+func NewBranchesFixer(client *github.Client, verbose bool) *BranchesFixer {
+	return &BranchesFixer{
+		client:  client,
+		verbose: verbose,
+	}
+}
+
+// Name returns the fixer name
+func (f *BranchesFixer) Name() string {
+	return "branches"
+}
+
+// Fix deletes a forbidden branch
+func (f *BranchesFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	branch := issue.Data[checks.DataKeyBranch]
+	if branch == "" {
+		return failedResult(issue, errors.New("issue data missing branch"))
+	}
+
+	if err := f.client.DeleteBranch(branch); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to delete branch: %w", err))
+	}
+	f.client.InvalidateCache(github.CacheKeyBranches)
+
+	return successResult(issue)
+}
@@ -0,0 +1,71 @@
+package fix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// BranchRulesFixer fixes default branch rule issues by creating or updating a ruleset
+// built from the branch_rules config
+type BranchRulesFixer struct {
+	client  *github.Client
+	config  *config.BranchRulesConfig
+	verbose bool
+	// applied is set once the ruleset has been written, which fixes every branch rule issue
+	applied bool
+}
+
+// NewBranchRulesFixer creates a new branch rules fixer
+func NewBranchRulesFixer(client *github.Client, cfg *config.BranchRulesConfig, verbose bool) *BranchRulesFixer {
+	return &BranchRulesFixer{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Name returns the fixer name
+func (f *BranchRulesFixer) Name() string {
+	return "branch_rules"
+}
+
+// Fix writes every configured rule to the managed ruleset. The ruleset is owned by
+// branch_rules, so its rules are replaced rather than merged.
+func (f *BranchRulesFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	if f.config == nil {
+		return failedResult(issue, errors.New("branch rules not configured"))
+	}
+
+	if f.applied {
+		return successResult(issue)
+	}
+
+	req := checks.BranchRulesetRequest(f.config)
+
+	rulesets, err := f.client.GetRulesets()
+	if err != nil {
+		return failedResult(issue, fmt.Errorf("failed to fetch rulesets: %w", err))
+	}
+	for _, rs := range rulesets {
+		if rs.Name == req.Name && rs.SourceType != "Organization" {
+			if err := f.client.UpdateRuleset(rs.ID, req); err != nil {
+				return failedResult(issue, fmt.Errorf("failed to update ruleset: %w", err))
+			}
+			f.client.InvalidateCache(github.CacheKeyRulesets)
+			f.applied = true
+			return successResult(issue)
+		}
+	}
+
+	if _, err := f.client.CreateRuleset(req); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to create ruleset: %w", err))
+	}
+	f.client.InvalidateCache(github.CacheKeyRulesets)
+	f.applied = true
+	return successResult(issue)
+}
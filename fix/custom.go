@@ -0,0 +1,69 @@
+package fix
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// CustomFixer fixes custom check issues by re-running the check's command with REPOLINT_FIX=1
+type CustomFixer struct {
+	client  *github.Client
+	configs []config.CustomCheckConfig
+	verbose bool
+}
+
+// NewCustomFixer creates a new custom fixer
+func NewCustomFixer(client *github.Client, cfgs []config.CustomCheckConfig, verbose bool) *CustomFixer {
+	return &CustomFixer{
+		client:  client,
+		configs: cfgs,
+		verbose: verbose,
+	}
+}
+
+// Name returns the fixer name
+func (f *CustomFixer) Name() string {
+	return "custom"
+}
+
+// Fix runs the custom check's command in fix mode; a zero exit status means the issue was fixed
+func (f *CustomFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	name := issue.Data[checks.DataKeyCustomCheck]
+	if name == "" {
+		return failedResult(issue, errors.New("issue data missing custom check name"))
+	}
+
+	var cfg *config.CustomCheckConfig
+	for i := range f.configs {
+		if f.configs[i].Name == name {
+			cfg = &f.configs[i]
+			break
+		}
+	}
+	if cfg == nil {
+		return failedResult(issue, fmt.Errorf("custom check %q not found in config", name))
+	}
+
+	cmd, err := checks.CustomCommand(ctx, f.client, cfg, true)
+	if err != nil {
+		return failedResult(issue, err)
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if line, _, _ := strings.Cut(strings.TrimSpace(stderr.String()), "\n"); line != "" {
+			err = fmt.Errorf("%w: %s", err, line)
+		}
+		return failedResult(issue, fmt.Errorf("custom check command failed: %w", err))
+	}
+
+	return successResult(issue)
+}
@@ -52,12 +52,17 @@ func (f *FilesFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, erro
 		return failedResult(issue, fmt.Errorf("no config found for file '%s'", fileName))
 	}
 
-	if cfg.Reference == "" {
+	// The check records the reference it selected (e.g. per language); fall back to the config
+	reference := issue.Data[checks.DataKeyReference]
+	if reference == "" {
+		reference = cfg.Reference
+	}
+	if reference == "" {
 		return failedResult(issue, fmt.Errorf("file '%s' has no reference specified", fileName))
 	}
 
 	// Fetch the reference file content
-	refContent, err := github.ResolveReferenceFile(cfg.Reference, f.client)
+	refContent, err := github.ResolveReferenceFile(reference, f.client)
 	if err != nil {
 		return failedResult(issue, fmt.Errorf("failed to fetch reference file: %w", err))
 	}
@@ -11,6 +11,9 @@ import (
 	"github.com/sethrylan/gh-repolint/github"
 )
 
+// ErrFixDeferred is the error of a fix that was not confirmed, and so not attempted
+var ErrFixDeferred = errors.New("fix deferred")
+
 // Result represents the result of a fix attempt
 type Result struct {
 	Issue checks.Issue
@@ -43,9 +46,12 @@ type Fixer interface {
 
 // Orchestrator coordinates all fixers
 type Orchestrator struct {
-	client  *github.Client
-	config  *config.Config
-	fixers  map[checks.CheckType]Fixer
+	client           *github.Client
+	config           *config.Config
+	fixers           map[checks.CheckType]Fixer
+	allowDestructive bool
+	// confirm, when set, is asked before each fix is attempted
+	confirm func(issue checks.Issue) (bool, error)
 	verbose bool
 }
 
@@ -62,11 +68,37 @@ func NewOrchestrator(client *github.Client, cfg *config.Config, verbose bool) *O
 	o.fixers[checks.CheckTypeSettings] = NewSettingsFixer(client, cfg.Checks.Settings, verbose)
 	o.fixers[checks.CheckTypeActions] = NewActionsFixer(client, cfg.Checks.Actions, verbose)
 	o.fixers[checks.CheckTypeRulesets] = NewRulesetsFixer(client, cfg.Checks.Rulesets, verbose)
+	o.fixers[checks.CheckTypeBranchRules] = NewBranchRulesFixer(client, cfg.Checks.BranchRules, verbose)
 	o.fixers[checks.CheckTypeFiles] = NewFilesFixer(client, cfg.Checks.Files, verbose)
+	o.fixers[checks.CheckTypeAutolinks] = NewAutolinksFixer(client, cfg.Checks.Autolinks, verbose)
+	o.fixers[checks.CheckTypeLabels] = NewLabelsFixer(client, cfg.Checks.Labels, verbose)
+	o.fixers[checks.CheckTypeTopics] = NewTopicsFixer(client, verbose)
+	o.fixers[checks.CheckTypeBranches] = NewBranchesFixer(client, verbose)
+	o.fixers[checks.CheckTypeOrganization] = NewOrganizationFixer(client, cfg.Checks.Organization, verbose)
+	o.fixers[checks.CheckTypeCustom] = NewCustomFixer(client, cfg.Checks.Custom, verbose)
 
 	return o
 }
 
+// SetAllowDestructive controls whether destructive fixes (e.g. deleting branches) are applied
+func (o *Orchestrator) SetAllowDestructive(allow bool) {
+	o.allowDestructive = allow
+}
+
+// SetVerify controls whether settings fixes are confirmed by re-reading each setting after
+// it is updated, failing fixes that GitHub accepted but did not apply
+func (o *Orchestrator) SetVerify(enabled bool) {
+	if fixer, ok := o.fixers[checks.CheckTypeSettings].(*SettingsFixer); ok {
+		fixer.SetVerify(enabled)
+	}
+}
+
+// SetConfirm sets a function asked before each fix is attempted. Fixes it declines are
+// reported with ErrFixDeferred; an error from it stops fixing.
+func (o *Orchestrator) SetConfirm(confirm func(issue checks.Issue) (bool, error)) {
+	o.confirm = confirm
+}
+
 // Fix attempts to fix all fixable issues
 func (o *Orchestrator) Fix(ctx context.Context, issues []checks.Issue) ([]Result, error) {
 	var results []Result
@@ -81,6 +113,15 @@ func (o *Orchestrator) Fix(ctx context.Context, issues []checks.Issue) ([]Result
 			continue
 		}
 
+		if issue.Destructive && !o.allowDestructive {
+			results = append(results, Result{
+				Issue: issue,
+				Fixed: false,
+				Error: errors.New("destructive fix skipped (use --allow-destructive)"),
+			})
+			continue
+		}
+
 		fixer, ok := o.fixers[issue.Type]
 		if !ok {
 			results = append(results, Result{
@@ -91,6 +132,21 @@ func (o *Orchestrator) Fix(ctx context.Context, issues []checks.Issue) ([]Result
 			continue
 		}
 
+		if o.confirm != nil {
+			ok, err := o.confirm(issue)
+			if err != nil {
+				return results, err
+			}
+			if !ok {
+				results = append(results, Result{
+					Issue: issue,
+					Fixed: false,
+					Error: ErrFixDeferred,
+				})
+				continue
+			}
+		}
+
 		result, err := fixer.Fix(ctx, issue)
 		switch {
 		case err != nil:
@@ -0,0 +1,36 @@
+package fix_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/fix"
+)
+
+func TestOrchestrator_Fix_DeclinedIsDeferred(t *testing.T) {
+	o := fix.NewOrchestrator(nil, &config.Config{}, false)
+	asked := 0
+	o.SetConfirm(func(issue checks.Issue) (bool, error) {
+		asked++
+		return false, nil
+	})
+
+	issues := []checks.Issue{
+		{Type: checks.CheckTypeSettings, Name: "settings", Fixable: true, Data: map[string]string{checks.DataKeySetting: "wiki"}},
+		{Type: checks.CheckTypeSettings, Name: "settings", Fixable: false},
+	}
+	results, err := o.Fix(context.Background(), issues)
+	if err != nil {
+		t.Fatalf("Fix() error = %v", err)
+	}
+
+	if asked != 1 {
+		t.Errorf("confirm asked %d times, want 1 (only for fixable issues)", asked)
+	}
+	if len(results) != 2 || !errors.Is(results[0].Error, fix.ErrFixDeferred) || results[0].Fixed {
+		t.Errorf("Fix() results = %+v, want the fixable issue deferred", results)
+	}
+}
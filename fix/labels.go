@@ -0,0 +1,81 @@
+package fix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// LabelsFixer fixes missing or mismatched labels
+type LabelsFixer struct {
+	client  *github.Client
+	configs []config.LabelConfig
+	verbose bool
+}
+
+// NewLabelsFixer creates a new labels fixer
+func NewLabelsFixer(client *github.Client, cfgs []config.LabelConfig, verbose bool) *LabelsFixer {
+	return &LabelsFixer{
+		client:  client,
+		configs: cfgs,
+		verbose: verbose,
+	}
+}
+
+// Name returns the fixer name
+func (f *LabelsFixer) Name() string {
+	return "labels"
+}
+
+// Fix attempts to fix a label issue
+// Missing labels are created; existing labels are updated in place
+func (f *LabelsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	name := issue.Data[checks.DataKeyLabel]
+	if name == "" {
+		return failedResult(issue, errors.New("issue data missing label"))
+	}
+
+	// Find the config for this label
+	var cfg *config.LabelConfig
+	for i := range f.configs {
+		if strings.EqualFold(f.configs[i].Name, name) {
+			cfg = &f.configs[i]
+			break
+		}
+	}
+
+	if cfg == nil {
+		return failedResult(issue, fmt.Errorf("no config found for label '%s'", name))
+	}
+
+	labels, err := f.client.ListLabels()
+	if err != nil {
+		return failedResult(issue, fmt.Errorf("failed to fetch labels: %w", err))
+	}
+
+	req := &github.LabelRequest{
+		Name:        cfg.Name,
+		Color:       cfg.Color,
+		Description: cfg.Description,
+	}
+
+	for _, l := range labels {
+		if strings.EqualFold(l.Name, cfg.Name) {
+			if err := f.client.UpdateLabel(l.Name, req); err != nil {
+				return failedResult(issue, fmt.Errorf("failed to update label: %w", err))
+			}
+			return successResult(issue)
+		}
+	}
+
+	if err := f.client.CreateLabel(req); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to create label: %w", err))
+	}
+
+	return successResult(issue)
+}
@@ -0,0 +1,67 @@
+package fix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// OrganizationFixer fixes organization settings issues (requires org admin)
+type OrganizationFixer struct {
+	client  *github.Client
+	config  *config.OrganizationConfig
+	verbose bool
+}
+
+// NewOrganizationFixer creates a new organization fixer
+func NewOrganizationFixer(client *github.Client, cfg *config.OrganizationConfig, verbose bool) *OrganizationFixer {
+	return &OrganizationFixer{
+		client:  client,
+		config:  cfg,
+		verbose: verbose,
+	}
+}
+
+// Name returns the fixer name
+func (f *OrganizationFixer) Name() string {
+	return "organization"
+}
+
+// Fix attempts to fix an organization settings issue
+func (f *OrganizationFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	if f.config == nil {
+		return failedResult(issue, errors.New("organization settings not configured"))
+	}
+
+	// Workflow permissions are set through the Actions API rather than the organization
+	if issue.Data[checks.DataKeySetting] == "default_workflow_permissions" {
+		if err := f.client.UpdateOrganizationDefaultWorkflowPermissions(f.config.DefaultWorkflowPermissions); err != nil {
+			return failedResult(issue, fmt.Errorf("failed to update default workflow permissions: %w", err))
+		}
+		return successResult(issue)
+	}
+
+	req := &github.OrganizationUpdateRequest{}
+
+	switch setting := issue.Data[checks.DataKeySetting]; setting {
+	case "default_repository_permission":
+		permission := f.config.DefaultRepositoryPermission
+		req.DefaultRepositoryPermission = &permission
+	case "members_can_create_repositories":
+		req.MembersCanCreateRepositories = f.config.MembersCanCreateRepositories
+	case "":
+		return failedResult(issue, errors.New("issue data missing setting"))
+	default:
+		return failedResult(issue, fmt.Errorf("unknown setting: %s", setting))
+	}
+
+	if err := f.client.UpdateOrganization(req); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to update organization: %w", err))
+	}
+
+	return successResult(issue)
+}
@@ -48,10 +48,21 @@ func (f *RulesetsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, e
 		}
 	}
 
+	// Rulesets from a ruleset_set are not configured individually; their issues carry the reference
+	if cfg == nil && issue.Data[checks.DataKeyReference] != "" {
+		cfg = &config.RulesetConfig{Name: rulesetName, Reference: issue.Data[checks.DataKeyReference]}
+	}
+
 	if cfg == nil {
 		return failedResult(issue, fmt.Errorf("no config found for ruleset '%s'", rulesetName))
 	}
 
+	// Enforcement-only issues (e.g. promoting evaluate to active) keep the live rules,
+	// conditions and bypass actors rather than overwriting them from the reference
+	if enforcement := issue.Data[checks.DataKeyEnforcement]; enforcement != "" {
+		return f.fixEnforcement(issue, cfg, enforcement)
+	}
+
 	if cfg.Reference == "" {
 		return failedResult(issue, fmt.Errorf("ruleset '%s' has no reference specified", rulesetName))
 	}
@@ -63,17 +74,9 @@ func (f *RulesetsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, e
 	}
 
 	// Check if ruleset exists to determine if we need to create or update
-	rulesets, err := f.client.GetRulesets()
+	rulesetID, err := f.findRulesetID(cfg.Name)
 	if err != nil {
-		return failedResult(issue, fmt.Errorf("failed to fetch rulesets: %w", err))
-	}
-
-	var rulesetID int
-	for _, rs := range rulesets {
-		if rs.Name == cfg.Name {
-			rulesetID = rs.ID
-			break
-		}
+		return failedResult(issue, err)
 	}
 
 	if rulesetID == 0 {
@@ -85,6 +88,47 @@ func (f *RulesetsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, e
 	return f.updateRulesetByID(issue, cfg, refRuleset, rulesetID)
 }
 
+// fixEnforcement reads the live ruleset and re-sends it with only the enforcement changed
+func (f *RulesetsFixer) fixEnforcement(issue checks.Issue, cfg *config.RulesetConfig, enforcement string) (*Result, error) {
+	rulesetID, err := f.findRulesetID(cfg.Name)
+	if err != nil {
+		return failedResult(issue, err)
+	}
+	if rulesetID == 0 {
+		return failedResult(issue, fmt.Errorf("ruleset '%s' does not exist", cfg.Name))
+	}
+
+	liveRuleset, err := f.client.GetRuleset(rulesetID)
+	if err != nil {
+		return failedResult(issue, fmt.Errorf("failed to fetch live ruleset: %w", err))
+	}
+
+	req := f.buildRulesetRequest(cfg, liveRuleset)
+	req.Enforcement = enforcement
+
+	if err := f.client.UpdateRuleset(rulesetID, req); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to update ruleset enforcement: %w", err))
+	}
+	f.client.InvalidateCache(github.CacheKeyRulesets)
+
+	return successResult(issue)
+}
+
+// findRulesetID returns the ID of the named ruleset, or 0 if it does not exist
+func (f *RulesetsFixer) findRulesetID(name string) (int, error) {
+	rulesets, err := f.client.GetRulesets()
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch rulesets: %w", err)
+	}
+
+	for _, rs := range rulesets {
+		if rs.Name == name {
+			return rs.ID, nil
+		}
+	}
+	return 0, nil
+}
+
 func (f *RulesetsFixer) createRuleset(issue checks.Issue, cfg *config.RulesetConfig, refRuleset *github.Ruleset) (*Result, error) {
 	req := f.buildRulesetRequest(cfg, refRuleset)
 
@@ -92,6 +136,7 @@ func (f *RulesetsFixer) createRuleset(issue checks.Issue, cfg *config.RulesetCon
 	if err != nil {
 		return failedResult(issue, fmt.Errorf("failed to create ruleset: %w", err))
 	}
+	f.client.InvalidateCache(github.CacheKeyRulesets)
 
 	return successResult(issue)
 }
@@ -102,14 +147,18 @@ func (f *RulesetsFixer) updateRulesetByID(issue checks.Issue, cfg *config.Rulese
 	if err := f.client.UpdateRuleset(rulesetID, req); err != nil {
 		return failedResult(issue, fmt.Errorf("failed to update ruleset: %w", err))
 	}
+	f.client.InvalidateCache(github.CacheKeyRulesets)
 
 	return successResult(issue)
 }
 
 // buildRulesetRequest creates a RulesetCreateRequest from the reference ruleset
+// The reference ruleset is deep-copied first so normalizing it never mutates the caller's value
 func (f *RulesetsFixer) buildRulesetRequest(cfg *config.RulesetConfig, refRuleset *github.Ruleset) *github.RulesetCreateRequest {
+	ruleset := refRuleset.Clone()
+
 	// Ensure conditions have proper include/exclude arrays (GitHub API requires both)
-	conditions := refRuleset.Conditions
+	conditions := ruleset.Conditions
 	if conditions != nil && conditions.RefName != nil {
 		if conditions.RefName.Include == nil {
 			conditions.RefName.Include = []string{}
@@ -120,17 +169,17 @@ func (f *RulesetsFixer) buildRulesetRequest(cfg *config.RulesetConfig, refRulese
 	}
 
 	// Ensure bypass actors is not nil
-	bypassActors := refRuleset.BypassActors
+	bypassActors := ruleset.BypassActors
 	if bypassActors == nil {
 		bypassActors = []github.BypassActor{}
 	}
 
 	req := &github.RulesetCreateRequest{
 		Name:         cfg.Name, // Use the configured name, not the reference name
-		Target:       refRuleset.Target,
-		Enforcement:  refRuleset.Enforcement,
+		Target:       ruleset.Target,
+		Enforcement:  ruleset.Enforcement,
 		Conditions:   conditions,
-		Rules:        refRuleset.Rules,
+		Rules:        ruleset.Rules,
 		BypassActors: bypassActors,
 	}
 
@@ -0,0 +1,65 @@
+package fix
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+func TestRulesetsFixer_BuildRulesetRequest_DoesNotMutateReference(t *testing.T) {
+	// Test that normalizing nil slices to empty slices happens on a copy, so a reference
+	// ruleset shared across multiple ruleset configs is never mutated.
+
+	ref := &github.Ruleset{
+		Name:        "reference",
+		Target:      "branch",
+		Enforcement: "active",
+		Conditions: &github.RulesetConditions{
+			RefName: &github.RefNameCondition{
+				Include: []string{"~DEFAULT_BRANCH"},
+				Exclude: nil, // Normalized to an empty slice in the request
+			},
+		},
+		Rules: []github.RulesetRule{
+			{Type: "pull_request", Parameters: map[string]any{"required_approving_review_count": float64(1)}},
+		},
+		BypassActors: nil, // Normalized to an empty slice in the request
+	}
+	original := ref.Clone()
+
+	fixer := NewRulesetsFixer(nil, nil, false)
+
+	first := fixer.buildRulesetRequest(&config.RulesetConfig{Name: "main"}, ref)
+	second := fixer.buildRulesetRequest(&config.RulesetConfig{Name: "release"}, ref)
+
+	if !reflect.DeepEqual(ref, original) {
+		t.Errorf("buildRulesetRequest() mutated the reference ruleset: got %+v, want %+v", ref, original)
+	}
+
+	if second.Name != "release" {
+		t.Errorf("second request Name = %q, want %q", second.Name, "release")
+	}
+
+	if !reflect.DeepEqual(first.Conditions, second.Conditions) {
+		t.Errorf("requests built from the same reference differ: %+v vs %+v", first.Conditions, second.Conditions)
+	}
+
+	if first.Conditions.RefName.Exclude == nil {
+		t.Error("request Conditions.RefName.Exclude should be normalized to an empty slice")
+	}
+
+	if first.BypassActors == nil {
+		t.Error("request BypassActors should be normalized to an empty slice")
+	}
+
+	// Mutating one request must not affect the other or the reference
+	first.Conditions.RefName.Include[0] = "refs/heads/mutated"
+	if second.Conditions.RefName.Include[0] != "~DEFAULT_BRANCH" {
+		t.Errorf("requests share condition slices: second Include = %v", second.Conditions.RefName.Include)
+	}
+	if ref.Conditions.RefName.Include[0] != "~DEFAULT_BRANCH" {
+		t.Errorf("request shares condition slices with reference: Include = %v", ref.Conditions.RefName.Include)
+	}
+}
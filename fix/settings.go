@@ -14,6 +14,7 @@ import (
 type SettingsFixer struct {
 	client  *github.Client
 	config  *config.SettingsConfig
+	verify  bool
 	verbose bool
 }
 
@@ -31,8 +32,45 @@ func (f *SettingsFixer) Name() string {
 	return "settings"
 }
 
+// SetVerify controls whether each applied fix is confirmed by re-reading the setting
+func (f *SettingsFixer) SetVerify(enabled bool) {
+	f.verify = enabled
+}
+
 // Fix attempts to fix a repository issue
 func (f *SettingsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	result, err := f.apply(issue)
+	if err != nil || !result.Fixed {
+		return result, err
+	}
+	f.client.InvalidateCache(github.CacheKeyRepository)
+
+	if !f.verify {
+		return result, nil
+	}
+	return f.verifyFix(ctx, issue)
+}
+
+// verifyFix re-runs the settings check against fresh repository state and fails
+// the fix if the setting still differs. GitHub can accept an update without applying it,
+// e.g. for features the repository's plan or type does not support.
+func (f *SettingsFixer) verifyFix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	remaining, err := checks.NewSettingsCheck(f.client, f.config, f.verbose).Run(ctx)
+	if err != nil {
+		return failedResult(issue, fmt.Errorf("fix was applied but could not be verified: %w", err))
+	}
+
+	setting := issue.Data[checks.DataKeySetting]
+	for _, other := range remaining {
+		if other.Data[checks.DataKeySetting] == setting {
+			return failedResult(issue, fmt.Errorf("fix was applied but did not take effect: %s", other.Message))
+		}
+	}
+	return successResult(issue)
+}
+
+// apply updates the setting of the issue to its configured value
+func (f *SettingsFixer) apply(issue checks.Issue) (*Result, error) {
 	setting := issue.Data[checks.DataKeySetting]
 	if setting == "" {
 		return failedResult(issue, errors.New("issue data missing setting"))
@@ -47,6 +85,12 @@ func (f *SettingsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, e
 		return f.fixDependabotAlerts(issue)
 	case "dependabot_security_updates":
 		return f.fixDependabotSecurityUpdates(issue)
+	case "dependency_graph":
+		return f.fixDependencyGraph(issue)
+	case "advanced_security":
+		return f.fixAdvancedSecurity(issue)
+	case "secret_scanning_non_provider_patterns":
+		return f.fixSecretScanningNonProviderPatterns(issue)
 	}
 
 	// Handle repository settings fixes
@@ -61,21 +105,33 @@ func (f *SettingsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, e
 		req.HasProjects = f.config.Projects
 	case "discussions":
 		req.HasDiscussions = f.config.Discussions
+	case "downloads":
+		req.HasDownloads = f.config.Downloads
+	case "allow_forking":
+		req.AllowForking = f.config.AllowForking
+	case "web_commit_signoff_required":
+		req.WebCommitSignoffRequired = f.config.WebCommitSignoffRequired
 	case "merge_commit":
 		if f.config.Merge == nil {
 			return failedResult(issue, errors.New("merge settings not configured"))
 		}
-		req.AllowMergeCommit = f.config.Merge.AllowMergeCommit
+		if err := f.setMergeMethods(req); err != nil {
+			return failedResult(issue, err)
+		}
 	case "squash_merge":
 		if f.config.Merge == nil {
 			return failedResult(issue, errors.New("merge settings not configured"))
 		}
-		req.AllowSquashMerge = f.config.Merge.AllowSquashMerge
+		if err := f.setMergeMethods(req); err != nil {
+			return failedResult(issue, err)
+		}
 	case "rebase_merge":
 		if f.config.Merge == nil {
 			return failedResult(issue, errors.New("merge settings not configured"))
 		}
-		req.AllowRebaseMerge = f.config.Merge.AllowRebaseMerge
+		if err := f.setMergeMethods(req); err != nil {
+			return failedResult(issue, err)
+		}
 	case "auto_merge":
 		if f.config.Merge == nil {
 			return failedResult(issue, errors.New("merge settings not configured"))
@@ -91,6 +147,12 @@ func (f *SettingsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, e
 			return failedResult(issue, errors.New("merge settings not configured"))
 		}
 		req.AllowUpdateBranch = f.config.Merge.AlwaysSuggestUpdatingPullRequestBranches
+	case "squash_merge_commit_message":
+		if f.config.Merge == nil {
+			return failedResult(issue, errors.New("merge settings not configured"))
+		}
+		message := f.config.Merge.SquashMergeCommitMessage
+		req.SquashMergeCommitMessage = &message
 	default:
 		return failedResult(issue, fmt.Errorf("unknown setting: %s", setting))
 	}
@@ -102,6 +164,36 @@ func (f *SettingsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, e
 	return successResult(issue)
 }
 
+// setMergeMethods sets every configured merge method on req, so that disabling one
+// method and enabling another happen in a single update. GitHub rejects an update
+// that would leave no merge method enabled, so that is reported up front instead.
+func (f *SettingsFixer) setMergeMethods(req *github.RepoUpdateRequest) error {
+	merge := f.config.Merge
+	req.AllowMergeCommit = merge.AllowMergeCommit
+	req.AllowSquashMerge = merge.AllowSquashMerge
+	req.AllowRebaseMerge = merge.AllowRebaseMerge
+
+	repo, err := f.client.GetRepository()
+	if err != nil {
+		return fmt.Errorf("failed to fetch repository: %w", err)
+	}
+
+	if !boolOr(req.AllowMergeCommit, repo.AllowMergeCommit) &&
+		!boolOr(req.AllowSquashMerge, repo.AllowSquashMerge) &&
+		!boolOr(req.AllowRebaseMerge, repo.AllowRebaseMerge) {
+		return config.ErrNoMergeMethod
+	}
+	return nil
+}
+
+// boolOr returns the value of b, or fallback when b is nil
+func boolOr(b *bool, fallback bool) bool {
+	if b == nil {
+		return fallback
+	}
+	return *b
+}
+
 func (f *SettingsFixer) fixPullRequestCreationPolicy(issue checks.Issue) (*Result, error) {
 	if f.config.PullRequestCreationPolicy == "" {
 		return failedResult(issue, errors.New("pull_request_creation_policy not configured"))
@@ -167,3 +259,39 @@ func (f *SettingsFixer) fixDependabotSecurityUpdates(issue checks.Issue) (*Resul
 
 	return successResult(issue)
 }
+
+func (f *SettingsFixer) fixDependencyGraph(issue checks.Issue) (*Result, error) {
+	if f.config.Dependabot == nil || f.config.Dependabot.DependencyGraph == nil {
+		return failedResult(issue, errors.New("dependency graph not configured"))
+	}
+
+	if err := f.client.UpdateDependencyGraph(*f.config.Dependabot.DependencyGraph); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to update dependency graph: %w", err))
+	}
+
+	return successResult(issue)
+}
+
+func (f *SettingsFixer) fixAdvancedSecurity(issue checks.Issue) (*Result, error) {
+	if f.config.AdvancedSecurity == nil {
+		return failedResult(issue, errors.New("advanced security not configured"))
+	}
+
+	if err := f.client.UpdateAdvancedSecurity(*f.config.AdvancedSecurity); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to update advanced security: %w", err))
+	}
+
+	return successResult(issue)
+}
+
+func (f *SettingsFixer) fixSecretScanningNonProviderPatterns(issue checks.Issue) (*Result, error) {
+	if f.config.SecretScanningNonProvider == nil {
+		return failedResult(issue, errors.New("secret scanning non-provider patterns not configured"))
+	}
+
+	if err := f.client.UpdateSecretScanningNonProviderPatterns(*f.config.SecretScanningNonProvider); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to update secret scanning non-provider patterns: %w", err))
+	}
+
+	return successResult(issue)
+}
@@ -19,6 +19,7 @@ func TestSettingsFixer_Fix_NilMergeConfig(t *testing.T) {
 		"auto_merge",
 		"delete_branch_on_merge",
 		"update_branch",
+		"squash_merge_commit_message",
 	}
 
 	for _, setting := range mergeSettings {
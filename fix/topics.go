@@ -0,0 +1,67 @@
+package fix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/github"
+)
+
+// TopicsFixer fixes repository topic issues
+type TopicsFixer struct {
+	client  *github.Client
+	verbose bool
+}
+
+// NewTopicsFixer creates a new topics fixer
+func NewTopicsFixer(client *github.Client, verbose bool) *TopicsFixer {
+	return &TopicsFixer{
+		client:  client,
+		verbose: verbose,
+	}
+}
+
+// Name returns the fixer name
+func (f *TopicsFixer) Name() string {
+	return "topics"
+}
+
+// Fix adds a missing required topic, or removes a topic that is not allowed. Topics are
+// re-read before each update, since the API replaces the whole list, and a topic that is
+// already added or removed is left alone.
+func (f *TopicsFixer) Fix(ctx context.Context, issue checks.Issue) (*Result, error) {
+	topic := issue.Data[checks.DataKeyTopic]
+	if topic == "" {
+		return failedResult(issue, errors.New("issue data missing topic"))
+	}
+	action := issue.Data[checks.DataKeyTopicAction]
+
+	topics, err := f.client.GetTopics()
+	if err != nil {
+		return failedResult(issue, fmt.Errorf("failed to fetch topics: %w", err))
+	}
+
+	switch action {
+	case checks.TopicActionAdd:
+		if slices.Contains(topics, topic) {
+			return successResult(issue)
+		}
+		topics = append(topics, topic)
+	case checks.TopicActionRemove:
+		if !slices.Contains(topics, topic) {
+			return successResult(issue)
+		}
+		topics = slices.DeleteFunc(topics, func(t string) bool { return t == topic })
+	default:
+		return failedResult(issue, fmt.Errorf("unknown topic action %q", action))
+	}
+
+	if err := f.client.SetTopics(topics); err != nil {
+		return failedResult(issue, fmt.Errorf("failed to update topics: %w", err))
+	}
+
+	return successResult(issue)
+}
@@ -0,0 +1,79 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Repository roles, from least to most privileged
+const (
+	RoleNone     = "none"
+	RoleRead     = "read"
+	RoleTriage   = "triage"
+	RoleWrite    = "write"
+	RoleMaintain = "maintain"
+	RoleAdmin    = "admin"
+)
+
+var roleOrder = []string{RoleNone, RoleRead, RoleTriage, RoleWrite, RoleMaintain, RoleAdmin}
+
+// Role returns the most privileged role the permissions grant
+func (p RepositoryPermissions) Role() string {
+	switch {
+	case p.Admin:
+		return RoleAdmin
+	case p.Maintain:
+		return RoleMaintain
+	case p.Push:
+		return RoleWrite
+	case p.Triage:
+		return RoleTriage
+	case p.Pull:
+		return RoleRead
+	}
+	return RoleNone
+}
+
+// RoleAtLeast reports whether role grants at least the access of required
+func RoleAtLeast(role, required string) bool {
+	return slices.Index(roleOrder, role) >= slices.Index(roleOrder, required)
+}
+
+// TokenScopes returns the OAuth scopes of the current token, from the X-OAuth-Scopes
+// header. ok is false for tokens that do not report scopes, such as fine-grained
+// personal access tokens and GitHub App tokens.
+func (c *Client) TokenScopes() (scopes []string, ok bool, err error) {
+	var header http.Header
+	err = c.retry(true, func() error {
+		if c.verbose {
+			fmt.Fprintf(os.Stderr, "[API] GET rate_limit\n")
+		}
+		resp, err := c.rest.Request("GET", "rate_limit", nil)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		header = resp.Header
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	values, ok := header[http.CanonicalHeaderKey("X-OAuth-Scopes")]
+	if !ok {
+		return nil, false, nil
+	}
+	scopes = []string{}
+	for _, value := range values {
+		for scope := range strings.SplitSeq(value, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+	return scopes, true, nil
+}
@@ -0,0 +1,24 @@
+package github
+
+import "testing"
+
+func TestRepositoryPermissionsRole(t *testing.T) {
+	tests := []struct {
+		name  string
+		perms RepositoryPermissions
+		want  string
+	}{
+		{"admin", RepositoryPermissions{Admin: true, Maintain: true, Push: true, Triage: true, Pull: true}, RoleAdmin},
+		{"write", RepositoryPermissions{Push: true, Triage: true, Pull: true}, RoleWrite},
+		{"read", RepositoryPermissions{Pull: true}, RoleRead},
+		{"none", RepositoryPermissions{}, RoleNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.perms.Role(); got != tt.want {
+				t.Errorf("Role() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,10 +7,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/cli/go-gh/v2/pkg/api"
@@ -20,15 +26,28 @@ import (
 const (
 	maxBackoffDuration = 1 * time.Minute
 	initialBackoff     = 1 * time.Second
+	perPage            = 100
+	// maxTransientRetries caps retries of transient server and connection errors, so
+	// that a genuine outage fails instead of retrying forever
+	maxTransientRetries = 3
 )
 
+// ErrRepositoryArchived is returned when the repository is archived and archived
+// repositories have not been explicitly included
+var ErrRepositoryArchived = errors.New("repository is archived")
+
 // Client provides cached GitHub API access with rate limiting
 type Client struct {
 	rest    *api.RESTClient
+	graphql *api.GraphQLClient
 	owner   string
 	repo    string
 	verbose bool
 
+	includeArchived bool
+	ref             string
+	onRateLimit     func(wait time.Duration)
+
 	cacheMu sync.RWMutex
 	cache   map[string]any
 }
@@ -40,8 +59,14 @@ func NewClient(owner, repo string, verbose bool) (*Client, error) {
 		return nil, fmt.Errorf("failed to create REST client: %w", err)
 	}
 
+	graphQLClient, err := api.DefaultGraphQLClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GraphQL client: %w", err)
+	}
+
 	return &Client{
 		rest:    restClient,
+		graphql: graphQLClient,
 		owner:   owner,
 		repo:    repo,
 		verbose: verbose,
@@ -64,14 +89,33 @@ func (c *Client) Repo() string {
 	return c.repo
 }
 
+// SetIncludeArchived controls whether archived repositories are returned by
+// GetRepository instead of ErrRepositoryArchived
+func (c *Client) SetIncludeArchived(include bool) {
+	c.includeArchived = include
+}
+
+// SetRef makes the "local" file accessors (FileExists, GetLocalFileContent, ListLocalFiles
+// and ReadWorkflowFile) read the repository at the given ref through the contents API
+// instead of from the working tree. An empty ref restores working-tree reads.
+func (c *Client) SetRef(ref string) {
+	c.ref = ref
+}
+
+// SetRateLimitHandler replaces the default stderr message printed when a request is
+// rate limited; fn is called with the backoff before each retry
+func (c *Client) SetRateLimitHandler(fn func(wait time.Duration)) {
+	c.onRateLimit = fn
+}
+
 // GetRepository fetches repository information
+// Returns ErrRepositoryArchived for archived repositories unless SetIncludeArchived(true) was called
 func (c *Client) GetRepository() (*Repository, error) {
 	cacheKey := fmt.Sprintf("repo:%s/%s", c.owner, c.repo)
 
-	if cached := c.getFromCache(cacheKey); cached != nil {
-		if repo, ok := cached.(*Repository); ok {
-			return repo, nil
-		}
+	if cached, ok := cacheGet[*Repository](c, cacheKey); ok {
+		repoCopy := *cached
+		return &repoCopy, nil
 	}
 
 	var repo Repository
@@ -81,11 +125,12 @@ func (c *Client) GetRepository() (*Repository, error) {
 		return nil, err
 	}
 
-	if repo.Archived {
-		return nil, fmt.Errorf("repository %s/%s is archived", c.owner, c.repo)
+	if repo.Archived && !c.includeArchived {
+		return nil, fmt.Errorf("%s/%s: %w", c.owner, c.repo, ErrRepositoryArchived)
 	}
 
-	c.setCache(cacheKey, &repo)
+	repoCopy := repo
+	c.setCache(cacheKey, &repoCopy)
 	return &repo, nil
 }
 
@@ -105,10 +150,8 @@ func (c *Client) GetWorkflowPermissions() (*WorkflowPermissions, error) {
 func (c *Client) GetRulesets() ([]Ruleset, error) {
 	cacheKey := fmt.Sprintf("rulesets:%s/%s", c.owner, c.repo)
 
-	if cached := c.getFromCache(cacheKey); cached != nil {
-		if rulesets, ok := cached.([]Ruleset); ok {
-			return rulesets, nil
-		}
+	if cached, ok := cacheGet[[]Ruleset](c, cacheKey); ok {
+		return cloneRulesets(cached), nil
 	}
 
 	var rulesets []Ruleset
@@ -118,7 +161,7 @@ func (c *Client) GetRulesets() ([]Ruleset, error) {
 		return nil, err
 	}
 
-	c.setCache(cacheKey, rulesets)
+	c.setCache(cacheKey, cloneRulesets(rulesets))
 	return rulesets, nil
 }
 
@@ -126,10 +169,8 @@ func (c *Client) GetRulesets() ([]Ruleset, error) {
 func (c *Client) GetRuleset(id int) (*Ruleset, error) {
 	cacheKey := fmt.Sprintf("ruleset:%s/%s/%d", c.owner, c.repo, id)
 
-	if cached := c.getFromCache(cacheKey); cached != nil {
-		if ruleset, ok := cached.(*Ruleset); ok {
-			return ruleset, nil
-		}
+	if cached, ok := cacheGet[*Ruleset](c, cacheKey); ok {
+		return cached.Clone(), nil
 	}
 
 	var ruleset Ruleset
@@ -139,7 +180,7 @@ func (c *Client) GetRuleset(id int) (*Ruleset, error) {
 		return nil, err
 	}
 
-	c.setCache(cacheKey, &ruleset)
+	c.setCache(cacheKey, ruleset.Clone())
 	return &ruleset, nil
 }
 
@@ -147,10 +188,8 @@ func (c *Client) GetRuleset(id int) (*Ruleset, error) {
 func (c *Client) GetFileContent(filePath string) ([]byte, error) {
 	cacheKey := fmt.Sprintf("file:%s/%s/%s", c.owner, c.repo, filePath)
 
-	if cached := c.getFromCache(cacheKey); cached != nil {
-		if content, ok := cached.([]byte); ok {
-			return content, nil
-		}
+	if cached, ok := cacheGet[[]byte](c, cacheKey); ok {
+		return bytes.Clone(cached), nil
 	}
 
 	var content FileContent
@@ -169,22 +208,29 @@ func (c *Client) GetFileContent(filePath string) ([]byte, error) {
 		return nil, fmt.Errorf("failed to decode content: %w", err)
 	}
 
-	c.setCache(cacheKey, decoded)
+	c.setCache(cacheKey, bytes.Clone(decoded))
 	return decoded, nil
 }
 
-// GetRemoteFileContent fetches a file from another repository
+// GetRemoteFileContent fetches a file from another repository's default branch
 func (c *Client) GetRemoteFileContent(owner, repo, filePath string) ([]byte, error) {
-	cacheKey := fmt.Sprintf("remote-file:%s/%s/%s", owner, repo, filePath)
+	return c.GetRemoteFileContentAtRef(owner, repo, filePath, "")
+}
 
-	if cached := c.getFromCache(cacheKey); cached != nil {
-		if content, ok := cached.([]byte); ok {
-			return content, nil
-		}
+// GetRemoteFileContentAtRef fetches a file from another repository at the given ref
+// An empty ref uses the repository's default branch
+func (c *Client) GetRemoteFileContentAtRef(owner, repo, filePath, ref string) ([]byte, error) {
+	cacheKey := fmt.Sprintf("remote-file:%s/%s/%s@%s", owner, repo, filePath, ref)
+
+	if cached, ok := cacheGet[[]byte](c, cacheKey); ok {
+		return bytes.Clone(cached), nil
 	}
 
 	var content FileContent
 	path := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, filePath)
+	if ref != "" {
+		path += "?ref=" + url.QueryEscape(ref)
+	}
 
 	if err := c.doWithRetry("GET", path, nil, &content); err != nil {
 		return nil, err
@@ -199,7 +245,7 @@ func (c *Client) GetRemoteFileContent(owner, repo, filePath string) ([]byte, err
 		return nil, fmt.Errorf("failed to decode content: %w", err)
 	}
 
-	c.setCache(cacheKey, decoded)
+	c.setCache(cacheKey, bytes.Clone(decoded))
 	return decoded, nil
 }
 
@@ -256,6 +302,73 @@ func (c *Client) DisableAutomatedSecurityFixes() error {
 	return c.doWithRetry("DELETE", path, nil, nil)
 }
 
+// GetDependencyGraphEnabled checks if the dependency graph is enabled.
+// The dependency graph is always enabled for public repositories. For other
+// repositories the security_and_analysis status is used when the token has admin
+// access, falling back to the SBOM endpoint, which returns 404 when it is disabled.
+func (c *Client) GetDependencyGraphEnabled() (bool, error) {
+	repo, err := c.GetRepository()
+	if err != nil {
+		return false, err
+	}
+	if repo.Visibility == "public" {
+		return true, nil
+	}
+	if status := repo.SecurityAndAnalysis.DependencyGraph.Status; status != "" {
+		return status == "enabled", nil
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/dependency-graph/sbom", c.owner, c.repo)
+	var sbom map[string]any
+	if err := c.doWithRetry("GET", path, nil, &sbom); err != nil {
+		if IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// UpdateDependencyGraph enables or disables the dependency graph
+func (c *Client) UpdateDependencyGraph(enabled bool) error {
+	status := "disabled"
+	if enabled {
+		status = "enabled"
+	}
+	return c.UpdateRepository(&RepoUpdateRequest{
+		SecurityAndAnalysis: &SecurityAndAnalysisUpdate{
+			DependencyGraph: &SecurityFeature{Status: status},
+		},
+	})
+}
+
+// UpdateAdvancedSecurity enables or disables GitHub Advanced Security
+func (c *Client) UpdateAdvancedSecurity(enabled bool) error {
+	status := "disabled"
+	if enabled {
+		status = "enabled"
+	}
+	return c.UpdateRepository(&RepoUpdateRequest{
+		SecurityAndAnalysis: &SecurityAndAnalysisUpdate{
+			AdvancedSecurity: &SecurityFeature{Status: status},
+		},
+	})
+}
+
+// UpdateSecretScanningNonProviderPatterns enables or disables secret scanning for
+// non-provider patterns (generic secrets such as private keys and connection strings)
+func (c *Client) UpdateSecretScanningNonProviderPatterns(enabled bool) error {
+	status := "disabled"
+	if enabled {
+		status = "enabled"
+	}
+	return c.UpdateRepository(&RepoUpdateRequest{
+		SecurityAndAnalysis: &SecurityAndAnalysisUpdate{
+			SecretScanningNonProviderPatterns: &SecurityFeature{Status: status},
+		},
+	})
+}
+
 // GetWorkflow fetches and parses a workflow file
 func (c *Client) GetWorkflow(path string) (*Workflow, error) {
 	content, err := c.GetLocalFileContent(path)
@@ -302,48 +415,374 @@ func (c *Client) UpdateRuleset(id int, req *RulesetCreateRequest) error {
 	return c.doWithRetry("PUT", path, req, nil)
 }
 
-// doWithRetry performs an API request with exponential backoff for rate limiting
-func (c *Client) doWithRetry(method, path string, body, result any) error {
-	backoff := initialBackoff
-	totalWait := time.Duration(0)
+// GetAutolinks fetches the repository's autolink references
+func (c *Client) GetAutolinks() ([]Autolink, error) {
+	path := fmt.Sprintf("repos/%s/%s/autolinks", c.owner, c.repo)
+	return getAllPages[Autolink](c, path)
+}
 
-	for {
+// CreateAutolink creates a new autolink reference
+func (c *Client) CreateAutolink(req *AutolinkCreateRequest) error {
+	path := fmt.Sprintf("repos/%s/%s/autolinks", c.owner, c.repo)
+	return c.doWithRetry("POST", path, req, nil)
+}
+
+// DeleteAutolink deletes an autolink reference by ID
+func (c *Client) DeleteAutolink(id int) error {
+	path := fmt.Sprintf("repos/%s/%s/autolinks/%d", c.owner, c.repo, id)
+	return c.doWithRetry("DELETE", path, nil, nil)
+}
+
+// GetLanguages fetches the repository's languages as a map of language to bytes of code
+func (c *Client) GetLanguages() (map[string]int, error) {
+	cacheKey := fmt.Sprintf("languages:%s/%s", c.owner, c.repo)
+
+	if cached, ok := cacheGet[map[string]int](c, cacheKey); ok {
+		return maps.Clone(cached), nil
+	}
+
+	var languages map[string]int
+	path := fmt.Sprintf("repos/%s/%s/languages", c.owner, c.repo)
+	if err := c.doWithRetry("GET", path, nil, &languages); err != nil {
+		return nil, err
+	}
+
+	c.setCache(cacheKey, maps.Clone(languages))
+	return languages, nil
+}
+
+// GetPrimaryLanguage returns the language with the most bytes of code, or "" if none
+func (c *Client) GetPrimaryLanguage() (string, error) {
+	languages, err := c.GetLanguages()
+	if err != nil {
+		return "", err
+	}
+
+	primary, most := "", -1
+	for lang, bytes := range languages {
+		// Break ties by name so the result is deterministic
+		if bytes > most || (bytes == most && lang < primary) {
+			primary, most = lang, bytes
+		}
+	}
+	return primary, nil
+}
+
+// ListLabels fetches all labels of the repository
+func (c *Client) ListLabels() ([]Label, error) {
+	path := fmt.Sprintf("repos/%s/%s/labels", c.owner, c.repo)
+	return getAllPages[Label](c, path)
+}
+
+// CreateLabel creates a new label
+func (c *Client) CreateLabel(req *LabelRequest) error {
+	path := fmt.Sprintf("repos/%s/%s/labels", c.owner, c.repo)
+	return c.doWithRetry("POST", path, req, nil)
+}
+
+// UpdateLabel updates the color and description of an existing label
+func (c *Client) UpdateLabel(name string, req *LabelRequest) error {
+	path := fmt.Sprintf("repos/%s/%s/labels/%s", c.owner, c.repo, url.PathEscape(name))
+	return c.doWithRetry("PATCH", path, req, nil)
+}
+
+// ListBranches fetches all branches of the repository
+func (c *Client) ListBranches() ([]Branch, error) {
+	cacheKey := fmt.Sprintf("branches:%s/%s", c.owner, c.repo)
+
+	if cached, ok := cacheGet[[]Branch](c, cacheKey); ok {
+		return slices.Clone(cached), nil
+	}
+
+	path := fmt.Sprintf("repos/%s/%s/branches", c.owner, c.repo)
+	branches, err := getAllPages[Branch](c, path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.setCache(cacheKey, slices.Clone(branches))
+	return branches, nil
+}
+
+// GetBranchRules fetches the rules that apply to a branch, from all active
+// repository and organization rulesets
+func (c *Client) GetBranchRules(branch string) ([]RulesetRule, error) {
+	path := fmt.Sprintf("repos/%s/%s/rules/branches/%s", c.owner, c.repo, url.PathEscape(branch))
+	return getAllPages[RulesetRule](c, path)
+}
+
+// GetCustomPropertyValues fetches the values of the repository's custom properties
+func (c *Client) GetCustomPropertyValues() ([]CustomPropertyValue, error) {
+	var values []CustomPropertyValue
+	path := fmt.Sprintf("repos/%s/%s/properties/values", c.owner, c.repo)
+
+	if err := c.doWithRetry("GET", path, nil, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// GetCheckRuns fetches the check runs reported on the commit a ref (branch, tag or SHA) points to
+func (c *Client) GetCheckRuns(ref string) ([]CheckRun, error) {
+	var all []CheckRun
+	for page := 1; ; page++ {
+		var runs CheckRuns
+		path := fmt.Sprintf("repos/%s/%s/commits/%s/check-runs?per_page=%d&page=%d", c.owner, c.repo, url.PathEscape(ref), perPage, page)
+		if err := c.doWithRetry("GET", path, nil, &runs); err != nil {
+			return nil, err
+		}
+		all = append(all, runs.CheckRuns...)
+		if len(runs.CheckRuns) < perPage || len(all) >= runs.TotalCount {
+			return all, nil
+		}
+	}
+}
+
+// HasRequiredStatusChecks checks if branch protection on a branch requires at least
+// one status check. Returns false when the branch is not protected.
+func (c *Client) HasRequiredStatusChecks(branch string) (bool, error) {
+	path := fmt.Sprintf("repos/%s/%s/branches/%s/protection/required_status_checks", c.owner, c.repo, url.PathEscape(branch))
+
+	var checks RequiredStatusChecks
+	if err := c.doWithRetry("GET", path, nil, &checks); err != nil {
+		// 404 means the branch is not protected or requires no status checks
+		if IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return len(checks.Contexts) > 0 || len(checks.Checks) > 0, nil
+}
+
+// ListOwnerRepositories lists all repositories of the client's owner
+// Organizations are listed via the orgs endpoint, falling back to the users endpoint
+func (c *Client) ListOwnerRepositories() ([]Repository, error) {
+	repos, err := getAllPages[Repository](c, fmt.Sprintf("orgs/%s/repos?type=all", c.owner))
+	if err != nil && IsNotFound(err) {
+		repos, err = getAllPages[Repository](c, fmt.Sprintf("users/%s/repos?type=owner", c.owner))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return repos, nil
+}
+
+// GetOrganization fetches the client owner's organization settings
+// Returns an error satisfying IsNotFound when the owner is a user rather than an organization
+func (c *Client) GetOrganization() (*Organization, error) {
+	var org Organization
+	path := fmt.Sprintf("orgs/%s", c.owner)
+
+	if err := c.doWithRetry("GET", path, nil, &org); err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// UpdateOrganization updates the client owner's organization settings (requires org admin)
+func (c *Client) UpdateOrganization(req *OrganizationUpdateRequest) error {
+	path := fmt.Sprintf("orgs/%s", c.owner)
+	return c.doWithRetry("PATCH", path, req, nil)
+}
+
+// GetOrganizationWorkflowPermissions fetches the default workflow permissions of the
+// client owner's organization
+func (c *Client) GetOrganizationWorkflowPermissions() (*WorkflowPermissions, error) {
+	var perms WorkflowPermissions
+	path := fmt.Sprintf("orgs/%s/actions/permissions/workflow", c.owner)
+
+	if err := c.doWithRetry("GET", path, nil, &perms); err != nil {
+		return nil, err
+	}
+	return &perms, nil
+}
+
+// UpdateOrganizationDefaultWorkflowPermissions sets the GITHUB_TOKEN permission ("read" or
+// "write") granted to workflows by default in the client owner's organization (requires org admin)
+func (c *Client) UpdateOrganizationDefaultWorkflowPermissions(permissions string) error {
+	path := fmt.Sprintf("orgs/%s/actions/permissions/workflow", c.owner)
+	req := map[string]any{
+		"default_workflow_permissions": permissions,
+	}
+	return c.doWithRetry("PUT", path, req, nil)
+}
+
+// GetActionsWorkflow fetches a workflow registered with GitHub Actions by its file name
+// (e.g. "ci.yml"). Returns nil when there is no such workflow, such as when the file is
+// not on the default branch.
+func (c *Client) GetActionsWorkflow(fileName string) (*ActionsWorkflow, error) {
+	var workflow ActionsWorkflow
+	path := fmt.Sprintf("repos/%s/%s/actions/workflows/%s", c.owner, c.repo, url.PathEscape(fileName))
+
+	if err := c.doWithRetry("GET", path, nil, &workflow); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &workflow, nil
+}
+
+// EnableWorkflow enables a disabled workflow by its file name
+func (c *Client) EnableWorkflow(fileName string) error {
+	path := fmt.Sprintf("repos/%s/%s/actions/workflows/%s/enable", c.owner, c.repo, url.PathEscape(fileName))
+	return c.doWithRetry("PUT", path, nil, nil)
+}
+
+// GetEnvironment fetches a deployment environment. Returns nil when the environment
+// does not exist.
+func (c *Client) GetEnvironment(name string) (*Environment, error) {
+	cacheKey := fmt.Sprintf("environment:%s/%s/%s", c.owner, c.repo, name)
+
+	if cached, ok := cacheGet[*Environment](c, cacheKey); ok {
+		if cached == nil {
+			return nil, nil
+		}
+		envCopy := *cached
+		return &envCopy, nil
+	}
+
+	var env Environment
+	path := fmt.Sprintf("repos/%s/%s/environments/%s", c.owner, c.repo, url.PathEscape(name))
+
+	if err := c.doWithRetry("GET", path, nil, &env); err != nil {
+		if IsNotFound(err) {
+			c.setCache(cacheKey, (*Environment)(nil))
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	envCopy := env
+	c.setCache(cacheKey, &envCopy)
+	return &env, nil
+}
+
+// GetTopics fetches the repository's topics
+func (c *Client) GetTopics() ([]string, error) {
+	var topics Topics
+	path := fmt.Sprintf("repos/%s/%s/topics", c.owner, c.repo)
+
+	if err := c.doWithRetry("GET", path, nil, &topics); err != nil {
+		return nil, err
+	}
+	return topics.Names, nil
+}
+
+// SetTopics replaces all of the repository's topics
+func (c *Client) SetTopics(names []string) error {
+	path := fmt.Sprintf("repos/%s/%s/topics", c.owner, c.repo)
+	// An empty list, not null, removes every topic
+	if names == nil {
+		names = []string{}
+	}
+	return c.doWithRetry("PUT", path, Topics{Names: names}, nil)
+}
+
+// DeleteBranch deletes a branch
+func (c *Client) DeleteBranch(name string) error {
+	path := fmt.Sprintf("repos/%s/%s/git/refs/heads/%s", c.owner, c.repo, name)
+	return c.doWithRetry("DELETE", path, nil, nil)
+}
+
+// GetCommit fetches a single commit by SHA or ref
+func (c *Client) GetCommit(ref string) (*Commit, error) {
+	var commit Commit
+	path := fmt.Sprintf("repos/%s/%s/commits/%s", c.owner, c.repo, ref)
+
+	if err := c.doWithRetry("GET", path, nil, &commit); err != nil {
+		return nil, err
+	}
+
+	return &commit, nil
+}
+
+// getAllPages fetches every page of a paginated list endpoint
+func getAllPages[T any](c *Client, path string) ([]T, error) {
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+
+	var all []T
+	for page := 1; ; page++ {
+		var items []T
+		pagePath := fmt.Sprintf("%s%sper_page=%d&page=%d", path, separator, perPage, page)
+		if err := c.doWithRetry("GET", pagePath, nil, &items); err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) < perPage {
+			return all, nil
+		}
+	}
+}
+
+// doWithRetry performs an API request with exponential backoff for rate limiting and
+// transient errors. POST requests are not retried after a transient error, since the
+// failed request may have been applied.
+func (c *Client) doWithRetry(method, path string, body, result any) error {
+	return c.retry(method != "POST", func() error {
 		if c.verbose {
 			fmt.Fprintf(os.Stderr, "[API] %s %s\n", method, path)
 		}
 
-		var err error
 		switch method {
 		case "GET":
-			err = c.rest.Get(path, result)
+			return c.rest.Get(path, result)
 		case "POST":
-			bodyReader, encErr := encodeBody(body)
-			if encErr != nil {
-				return encErr
+			bodyReader, err := encodeBody(body)
+			if err != nil {
+				return err
 			}
-			err = c.rest.Post(path, bodyReader, result)
+			return c.rest.Post(path, bodyReader, result)
 		case "PATCH":
-			bodyReader, encErr := encodeBody(body)
-			if encErr != nil {
-				return encErr
+			bodyReader, err := encodeBody(body)
+			if err != nil {
+				return err
 			}
-			err = c.rest.Patch(path, bodyReader, result)
+			return c.rest.Patch(path, bodyReader, result)
 		case "PUT":
-			bodyReader, encErr := encodeBody(body)
-			if encErr != nil {
-				return encErr
+			bodyReader, err := encodeBody(body)
+			if err != nil {
+				return err
 			}
-			err = c.rest.Put(path, bodyReader, result)
+			return c.rest.Put(path, bodyReader, result)
 		case "DELETE":
-			err = c.rest.Delete(path, result)
+			return c.rest.Delete(path, result)
 		default:
 			return fmt.Errorf("unsupported method: %s", method)
 		}
+	})
+}
 
+// retry calls an API request function, retrying with exponential backoff while it
+// fails with a rate limit error. With retryTransient, transient errors are also retried,
+// up to maxTransientRetries times.
+func (c *Client) retry(retryTransient bool, call func() error) error {
+	backoff := initialBackoff
+	totalWait := time.Duration(0)
+	transientRetries := 0
+
+	for {
+		err := call()
 		if err == nil {
 			return nil
 		}
 
+		if retryTransient && !isRateLimitError(err) && isTransientError(err) {
+			if transientRetries == maxTransientRetries {
+				return fmt.Errorf("giving up after %d retries: %w", transientRetries, err)
+			}
+			wait := initialBackoff << transientRetries
+			transientRetries++
+			if c.verbose {
+				fmt.Fprintf(os.Stderr, "Transient error (%v), retrying in %v...\n", err, wait)
+			}
+			time.Sleep(wait)
+			continue
+		}
+
 		// Check if this is a rate limit error
 		if !isRateLimitError(err) {
 			return err
@@ -353,7 +792,11 @@ func (c *Client) doWithRetry(method, path string, body, result any) error {
 			return fmt.Errorf("rate limit exceeded, waited %v: %w", totalWait, err)
 		}
 
-		fmt.Fprintf(os.Stderr, "Rate limited, waiting %v before retry...\n", backoff)
+		if c.onRateLimit != nil {
+			c.onRateLimit(backoff)
+		} else {
+			fmt.Fprintf(os.Stderr, "Rate limited, waiting %v before retry...\n", backoff)
+		}
 		time.Sleep(backoff)
 		totalWait += backoff
 		backoff *= 2
@@ -387,12 +830,41 @@ func isRateLimitError(err error) bool {
 		strings.Contains(errStr, "secondary rate limit")
 }
 
-// Cache methods
+// isTransientError checks if the error is likely to succeed when retried: a 502, 503
+// or 504 response, or a connection that was dropped mid-request
+func isTransientError(err error) bool {
+	var apiErr *api.HTTPError
+	if errors.As(err, &apiErr) {
+		return isTransientStatus(apiErr.StatusCode)
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return isTransientStatus(httpErr.StatusCode)
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET)
+}
+
+func isTransientStatus(status int) bool {
+	switch status {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
 
-func (c *Client) getFromCache(key string) any {
+// Cache methods
+//
+// Cached values are shared across checks and fixers, so callers must never hand out
+// a cached value that can be mutated. Mutable values (slices, maps, pointers) are
+// copied when stored and copied again when read.
+
+// cacheGet returns the cached value for key if present and of type T.
+// A value stored under the same key with a different type is treated as a miss.
+func cacheGet[T any](c *Client, key string) (T, bool) {
 	c.cacheMu.RLock()
 	defer c.cacheMu.RUnlock()
-	return c.cache[key]
+	value, ok := c.cache[key].(T)
+	return value, ok
 }
 
 func (c *Client) setCache(key string, value any) {
@@ -401,11 +873,34 @@ func (c *Client) setCache(key string, value any) {
 	c.cache[key] = value
 }
 
+// Cache key prefixes for InvalidateCache
+const (
+	CacheKeyRepository = "repo:"
+	// CacheKeyRulesets covers both the ruleset list and individual rulesets
+	CacheKeyRulesets = "ruleset"
+	CacheKeyBranches = "branches:"
+)
+
+// InvalidateCache drops every cached response whose key starts with keyPrefix, so that
+// the next read after a write fetches fresh state from the API
+func (c *Client) InvalidateCache(keyPrefix string) {
+	c.cacheMu.Lock()
+	defer c.cacheMu.Unlock()
+	for key := range c.cache {
+		if strings.HasPrefix(key, keyPrefix) {
+			delete(c.cache, key)
+		}
+	}
+}
+
 // CheckPermissions verifies the client has necessary permissions
 func (c *Client) CheckPermissions() error {
 	// Try to fetch repository to check basic access
 	_, err := c.GetRepository()
 	if err != nil {
+		if errors.Is(err, ErrRepositoryArchived) {
+			return err
+		}
 		return fmt.Errorf("insufficient permissions to access repository: %w", err)
 	}
 
@@ -446,9 +941,9 @@ func IsForbidden(err error) bool {
 	return strings.Contains(err.Error(), "403") || strings.Contains(err.Error(), "Forbidden")
 }
 
-// ReadLocalWorkflowFile reads a workflow file from the local filesystem
-func ReadLocalWorkflowFile(path string) (*Workflow, []byte, error) {
-	content, err := os.ReadFile(path) //nolint:gosec // Reading user-specified workflow files is intentional
+// ReadWorkflowFile reads and parses a workflow file from the working tree (or the ref set by SetRef)
+func (c *Client) ReadWorkflowFile(path string) (*Workflow, []byte, error) {
+	content, err := c.GetLocalFileContent(path)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -463,33 +958,84 @@ func ReadLocalWorkflowFile(path string) (*Workflow, []byte, error) {
 
 // ResolveReferenceFile resolves a reference file from local filesystem or remote repository
 func ResolveReferenceFile(reference string, client *Client) ([]byte, error) {
-	var content []byte
-	var err error
-
-	// First, try to read from local filesystem
-	content, err = os.ReadFile(reference) //nolint:gosec // Reading user-specified reference files is intentional
-	if err == nil {
-		// Successfully read from local file
-		return content, nil
-	}
+	// URLs are always remote; skip the local filesystem
+	if !IsURLReference(reference) {
+		content, err := os.ReadFile(reference) //nolint:gosec // Reading user-specified reference files is intentional
+		if err == nil {
+			// Successfully read from local file
+			return content, nil
+		}
 
-	// If local file doesn't exist, try remote repository lookup
-	if !os.IsNotExist(err) {
-		return nil, fmt.Errorf("failed to read local reference file: %w", err)
+		// If local file doesn't exist, try remote repository lookup
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read local reference file: %w", err)
+		}
 	}
 
-	// Parse reference as remote: owner/repo/path
-	parts := strings.SplitN(reference, "/", 3)
-	if len(parts) < 3 {
+	remote, err := ParseRemoteReference(reference)
+	if err != nil {
+		if IsURLReference(reference) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("reference file '%s' not found locally and invalid remote format (expected owner/repo/path)", reference)
 	}
 
-	refOwner, refRepo, refPath := parts[0], parts[1], parts[2]
-
 	// Fetch reference content from remote
-	content, err = client.GetRemoteFileContent(refOwner, refRepo, refPath)
+	content, err := client.GetRemoteFileContentAtRef(remote.Owner, remote.Repo, remote.Path, remote.Ref)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch remote reference file: %w", err)
 	}
 	return content, nil
 }
+
+// ResolveReferenceDir returns a reference to each file in a reference directory whose name
+// ends in ext, sorted by name. A local directory is used if it exists, otherwise the
+// reference is a remote directory (owner/repo/path[@ref]). Each returned reference can be
+// passed to ResolveReferenceFile.
+func ResolveReferenceDir(reference, ext string, client *Client) ([]string, error) {
+	if !IsURLReference(reference) {
+		entries, err := os.ReadDir(reference)
+		if err == nil {
+			var refs []string
+			for _, entry := range entries {
+				if !entry.IsDir() && strings.HasSuffix(entry.Name(), ext) {
+					refs = append(refs, filepath.Join(reference, entry.Name()))
+				}
+			}
+			return refs, nil
+		}
+		if !os.IsNotExist(err) {
+			return nil, fmt.Errorf("failed to read local reference directory: %w", err)
+		}
+	}
+
+	remote, err := ParseRemoteReference(reference)
+	if err != nil {
+		if IsURLReference(reference) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("reference directory '%s' not found locally and invalid remote format (expected owner/repo/path)", reference)
+	}
+
+	names, err := client.ListRemoteFilesAtRef(remote.Owner, remote.Repo, remote.Path, remote.Ref)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote reference directory: %w", err)
+	}
+	if names == nil {
+		return nil, fmt.Errorf("remote reference directory '%s' does not exist or is empty", reference)
+	}
+
+	slices.Sort(names)
+	var refs []string
+	for _, name := range names {
+		if !strings.HasSuffix(name, ext) {
+			continue
+		}
+		ref := fmt.Sprintf("%s/%s/%s/%s", remote.Owner, remote.Repo, strings.TrimSuffix(remote.Path, "/"), name)
+		if remote.Ref != "" {
+			ref += "@" + remote.Ref
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
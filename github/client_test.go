@@ -0,0 +1,72 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"syscall"
+	"testing"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"bad gateway", &api.HTTPError{StatusCode: 502}, true},
+		{"service unavailable", fmt.Errorf("request failed: %w", &api.HTTPError{StatusCode: 503}), true},
+		{"gateway timeout", &HTTPError{StatusCode: 504}, true},
+		{"internal server error", &api.HTTPError{StatusCode: 500}, false},
+		{"not found", &api.HTTPError{StatusCode: 404}, false},
+		{"unexpected EOF", fmt.Errorf("read body: %w", io.ErrUnexpectedEOF), true},
+		{"connection reset", fmt.Errorf("read tcp: %w", syscall.ECONNRESET), true},
+		{"other", errors.New("invalid character"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransientError(tt.err); got != tt.want {
+				t.Errorf("isTransientError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInvalidateCache(t *testing.T) {
+	c := &Client{cache: map[string]any{
+		"repo:o/r":      &Repository{},
+		"rulesets:o/r":  []Ruleset{},
+		"ruleset:o/r/1": &Ruleset{},
+		"branches:o/r":  []Branch{},
+		"languages:o/r": map[string]int{},
+		"remote-file:x": []byte{},
+	}}
+
+	c.InvalidateCache(CacheKeyRulesets)
+	c.InvalidateCache(CacheKeyRepository)
+
+	got := slices.Sorted(maps.Keys(c.cache))
+	want := []string{"branches:o/r", "languages:o/r", "remote-file:x"}
+	if !slices.Equal(got, want) {
+		t.Errorf("cache keys after InvalidateCache() = %v, want %v", got, want)
+	}
+}
+
+func TestHydrateTemplate(t *testing.T) {
+	c := &Client{owner: "acme", repo: "widgets", cache: map[string]any{
+		"repo:acme/widgets": &Repository{DefaultBranch: "trunk"},
+	}}
+
+	got, err := c.HydrateTemplate([]byte("{{ .owner }}/{{.repo}}@{{ .default_branch }} ${{ github.ref }} {{.default_branch}}"))
+	if err != nil {
+		t.Fatalf("HydrateTemplate() error = %v", err)
+	}
+	if want := "acme/widgets@trunk ${{ github.ref }} trunk"; string(got) != want {
+		t.Errorf("HydrateTemplate() = %q, want %q", got, want)
+	}
+}
@@ -2,25 +2,40 @@ package github
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
 )
 
-// FileExists checks if a local file exists
-func (c *Client) FileExists(filePath string) bool {
+// FileExists checks if a local file exists (or exists at the ref set by SetRef). Errors
+// other than the file not existing are returned.
+func (c *Client) FileExists(filePath string) (bool, error) {
+	if c.ref != "" {
+		_, err := c.GetRemoteFileContentAtRef(c.owner, c.repo, filePath, c.ref)
+		if IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}
+
 	fullPath := filePath
 	if !filepath.IsAbs(filePath) {
 		cwd, err := os.Getwd()
 		if err != nil {
-			return false
+			return false, err
 		}
 		fullPath = filepath.Join(cwd, filePath)
 	}
 
 	_, err := os.Stat(fullPath)
-	return err == nil
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
 }
 
 // WriteFile writes content to a file in the repository (for fixes)
@@ -43,8 +58,12 @@ func (c *Client) WriteFile(filePath string, content []byte) error {
 	return os.WriteFile(fullPath, content, 0600)
 }
 
-// GetLocalFileContent reads a file from the local repository
+// GetLocalFileContent reads a file from the local repository (or from the ref set by SetRef)
 func (c *Client) GetLocalFileContent(filePath string) ([]byte, error) {
+	if c.ref != "" {
+		return c.GetRemoteFileContentAtRef(c.owner, c.repo, filePath, c.ref)
+	}
+
 	cwd, err := os.Getwd()
 	if err != nil {
 		return nil, err
@@ -53,8 +72,62 @@ func (c *Client) GetLocalFileContent(filePath string) ([]byte, error) {
 	return os.ReadFile(fullPath) //nolint:gosec // Reading user-specified files is intentional
 }
 
+// ListLocalFiles returns the names of the files (not subdirectories) in a local
+// directory, or in that directory at the ref set by SetRef. An empty dir is the
+// repository root, and a missing directory has no files.
+func (c *Client) ListLocalFiles(dir string) ([]string, error) {
+	if c.ref != "" {
+		return c.ListRemoteFilesAtRef(c.owner, c.repo, dir, c.ref)
+	}
+	if dir == "" {
+		dir = "."
+	}
+
+	var names []string
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+// ListRemoteFilesAtRef returns the names of the files (not subdirectories) in a directory
+// of a repository at ref, or at the default branch if ref is empty. An empty dir is the
+// repository root, and a missing directory has no files.
+func (c *Client) ListRemoteFilesAtRef(owner, repo, dir, ref string) ([]string, error) {
+	var entries []FileContent
+	path := fmt.Sprintf("repos/%s/%s/contents/%s", owner, repo, dir)
+	if ref != "" {
+		path += "?ref=" + url.QueryEscape(ref)
+	}
+	if err := c.doWithRetry("GET", path, nil, &entries); err != nil {
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.Type == "file" {
+			names = append(names, entry.Name)
+		}
+	}
+	return names, nil
+}
+
 // HydrateTemplate interpolates template variables in the content
-// using the client's owner and repo as template data.
+// using the client's owner and repo, and the repository's default branch,
+// as template data. The repository is only fetched when the content uses
+// {{ .default_branch }}.
 //
 // Uses simple string replacement instead of Go's text/template to avoid
 // conflicts with GitHub Actions expression syntax (${{ }}), which uses
@@ -65,6 +138,14 @@ func (c *Client) HydrateTemplate(content []byte) ([]byte, error) {
 	result = strings.ReplaceAll(result, "{{.owner}}", c.owner)
 	result = strings.ReplaceAll(result, "{{ .repo }}", c.repo)
 	result = strings.ReplaceAll(result, "{{.repo}}", c.repo)
+	if strings.Contains(result, "{{ .default_branch }}") || strings.Contains(result, "{{.default_branch}}") {
+		repo, err := c.GetRepository()
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch default branch: %w", err)
+		}
+		result = strings.ReplaceAll(result, "{{ .default_branch }}", repo.DefaultBranch)
+		result = strings.ReplaceAll(result, "{{.default_branch}}", repo.DefaultBranch)
+	}
 	return []byte(result), nil
 }
 
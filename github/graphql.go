@@ -0,0 +1,63 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"slices"
+)
+
+// doGraphQL runs a named GraphQL query with exponential backoff for rate limiting.
+// The name is only used for verbose logging.
+func (c *Client) doGraphQL(name, query string, variables map[string]any, result any) error {
+	return c.retry(true, func() error {
+		if c.verbose {
+			fmt.Fprintf(os.Stderr, "[API] GraphQL %s\n", name)
+		}
+		return c.graphql.Do(query, variables, result)
+	})
+}
+
+// DiscussionCategory represents a repository discussion category
+type DiscussionCategory struct {
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// discussionCategoriesQuery lists a repository's discussion categories. Repositories
+// are limited to 25 categories, so a single page is enough.
+const discussionCategoriesQuery = `query DiscussionCategories($owner: String!, $repo: String!) {
+  repository(owner: $owner, name: $repo) {
+    discussionCategories(first: 100) {
+      nodes {
+        name
+        slug
+      }
+    }
+  }
+}`
+
+// GetDiscussionCategories fetches the repository's discussion categories, which are
+// only available through the GraphQL API
+func (c *Client) GetDiscussionCategories() ([]DiscussionCategory, error) {
+	cacheKey := fmt.Sprintf("discussion-categories:%s/%s", c.owner, c.repo)
+
+	if cached, ok := cacheGet[[]DiscussionCategory](c, cacheKey); ok {
+		return slices.Clone(cached), nil
+	}
+
+	var result struct {
+		Repository struct {
+			DiscussionCategories struct {
+				Nodes []DiscussionCategory `json:"nodes"`
+			} `json:"discussionCategories"`
+		} `json:"repository"`
+	}
+	variables := map[string]any{"owner": c.owner, "repo": c.repo}
+	if err := c.doGraphQL("DiscussionCategories", discussionCategoriesQuery, variables, &result); err != nil {
+		return nil, err
+	}
+
+	categories := result.Repository.DiscussionCategories.Nodes
+	c.setCache(cacheKey, slices.Clone(categories))
+	return categories, nil
+}
@@ -0,0 +1,98 @@
+package github
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	githubHost    = "github.com"
+	rawGitHubHost = "raw.githubusercontent.com"
+)
+
+// RemoteReference identifies a file in a GitHub repository, optionally at a specific ref
+type RemoteReference struct {
+	Owner string
+	Repo  string
+	// Ref is the branch, tag or commit; empty means the repository's default branch
+	Ref  string
+	Path string
+}
+
+// IsURLReference reports whether the reference is written as a URL rather than a path
+func IsURLReference(reference string) bool {
+	return strings.Contains(reference, "://")
+}
+
+// IsGitHubURLReference reports whether the reference is a github.com or
+// raw.githubusercontent.com URL, which are fetched through the authenticated API
+func IsGitHubURLReference(reference string) bool {
+	u, err := url.Parse(reference)
+	if err != nil || !IsURLReference(reference) {
+		return false
+	}
+	return u.Host == githubHost || u.Host == rawGitHubHost
+}
+
+// ParseRemoteReference parses a remote reference in one of the supported forms:
+//   - owner/repo/path
+//   - owner/repo/path@ref
+//   - https://github.com/owner/repo/blob/ref/path
+//   - https://raw.githubusercontent.com/owner/repo/ref/path
+//
+// For URLs the ref is taken to be a single path segment, so branch names containing
+// slashes are not supported.
+func ParseRemoteReference(reference string) (*RemoteReference, error) {
+	if !IsURLReference(reference) {
+		parts := strings.SplitN(reference, "/", 3)
+		if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid reference format: %s (expected owner/repo/path)", reference)
+		}
+		filePath, ref := parts[2], ""
+		if i := strings.LastIndex(filePath, "@"); i >= 0 {
+			filePath, ref = filePath[:i], filePath[i+1:]
+			if filePath == "" || ref == "" {
+				return nil, fmt.Errorf("invalid reference format: %s (expected owner/repo/path@ref)", reference)
+			}
+		}
+		return &RemoteReference{Owner: parts[0], Repo: parts[1], Ref: ref, Path: filePath}, nil
+	}
+
+	u, err := url.Parse(reference)
+	if err != nil {
+		return nil, fmt.Errorf("invalid reference URL %s: %w", reference, err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported reference URL scheme %q in %s (expected https)", u.Scheme, reference)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	switch u.Host {
+	case githubHost:
+		// owner/repo/blob/ref/path...
+		if len(segments) < 5 || segments[2] != "blob" {
+			return nil, fmt.Errorf("invalid GitHub URL reference %s (expected https://github.com/owner/repo/blob/ref/path)", reference)
+		}
+		return &RemoteReference{
+			Owner: segments[0],
+			Repo:  segments[1],
+			Ref:   segments[3],
+			Path:  strings.Join(segments[4:], "/"),
+		}, nil
+	case rawGitHubHost:
+		// owner/repo/ref/path...
+		if len(segments) < 4 {
+			return nil, fmt.Errorf("invalid raw GitHub URL reference %s (expected https://raw.githubusercontent.com/owner/repo/ref/path)", reference)
+		}
+		return &RemoteReference{
+			Owner: segments[0],
+			Repo:  segments[1],
+			Ref:   segments[2],
+			Path:  strings.Join(segments[3:], "/"),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported reference host %q in %s (expected %s or %s)", u.Host, reference, githubHost, rawGitHubHost)
+	}
+}
@@ -0,0 +1,46 @@
+package github
+
+import "testing"
+
+func TestParseRemoteReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		want      RemoteReference
+	}{
+		{"me/me/.repolint/ci.yml", RemoteReference{Owner: "me", Repo: "me", Path: ".repolint/ci.yml"}},
+		{"me/me/.repolint/ci.yml@v1", RemoteReference{Owner: "me", Repo: "me", Ref: "v1", Path: ".repolint/ci.yml"}},
+		{"https://github.com/me/me/blob/main/.repolint/ci.yml", RemoteReference{Owner: "me", Repo: "me", Ref: "main", Path: ".repolint/ci.yml"}},
+		{"https://raw.githubusercontent.com/me/me/v1.2.0/ruleset.json", RemoteReference{Owner: "me", Repo: "me", Ref: "v1.2.0", Path: "ruleset.json"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			got, err := ParseRemoteReference(tt.reference)
+			if err != nil {
+				t.Fatalf("ParseRemoteReference(%q) returned unexpected error: %v", tt.reference, err)
+			}
+			if *got != tt.want {
+				t.Errorf("ParseRemoteReference(%q) = %+v, want %+v", tt.reference, *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRemoteReference_Invalid(t *testing.T) {
+	invalid := []string{
+		"me/ci.yml",
+		"me/me/ci.yml@",
+		"https://gitlab.com/me/me/blob/main/ci.yml",
+		"http://github.com/me/me/blob/main/ci.yml",
+		"https://github.com/me/me/tree/main/ci.yml",
+		"https://raw.githubusercontent.com/me/me/main",
+	}
+
+	for _, reference := range invalid {
+		t.Run(reference, func(t *testing.T) {
+			if _, err := ParseRemoteReference(reference); err == nil {
+				t.Errorf("ParseRemoteReference(%q) should have returned an error", reference)
+			}
+		})
+	}
+}
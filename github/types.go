@@ -1,15 +1,26 @@
 package github
 
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
 // Repository represents a GitHub repository
 type Repository struct {
 	Name                      string `json:"name"`
 	FullName                  string `json:"full_name"`
+	Visibility                string `json:"visibility"`
 	DefaultBranch             string `json:"default_branch"`
 	Archived                  bool   `json:"archived"`
+	Fork                      bool   `json:"fork"`
 	HasIssues                 bool   `json:"has_issues"`
 	HasWiki                   bool   `json:"has_wiki"`
 	HasProjects               bool   `json:"has_projects"`
 	HasDiscussions            bool   `json:"has_discussions"`
+	HasDownloads              bool   `json:"has_downloads"`
+	AllowForking              bool   `json:"allow_forking"`
+	WebCommitSignoffRequired  bool   `json:"web_commit_signoff_required"`
 	PullRequestCreationPolicy string `json:"pull_request_creation_policy"`
 	AllowMergeCommit          bool   `json:"allow_merge_commit"`
 	AllowSquashMerge          bool   `json:"allow_squash_merge"`
@@ -17,6 +28,36 @@ type Repository struct {
 	AllowAutoMerge            bool   `json:"allow_auto_merge"`
 	DeleteBranchOnMerge       bool   `json:"delete_branch_on_merge"`
 	AllowUpdateBranch         bool   `json:"allow_update_branch"`
+	SquashMergeCommitMessage  string `json:"squash_merge_commit_message"`
+	Size                      int    `json:"size"`
+	Homepage                  string `json:"homepage"`
+	// SecurityAndAnalysis is only returned to users with admin access
+	SecurityAndAnalysis SecurityAndAnalysis `json:"security_and_analysis"`
+	// Permissions is the authenticated user's access to the repository
+	Permissions RepositoryPermissions `json:"permissions"`
+}
+
+// RepositoryPermissions represents the authenticated user's access to a repository
+type RepositoryPermissions struct {
+	Admin    bool `json:"admin"`
+	Maintain bool `json:"maintain"`
+	Push     bool `json:"push"`
+	Triage   bool `json:"triage"`
+	Pull     bool `json:"pull"`
+}
+
+// SecurityAndAnalysis represents the security and analysis features of a repository
+type SecurityAndAnalysis struct {
+	DependencyGraph SecurityFeature `json:"dependency_graph"`
+	// AdvancedSecurity is only returned when GitHub Advanced Security is available to the repository
+	AdvancedSecurity SecurityFeature `json:"advanced_security"`
+	// SecretScanningNonProviderPatterns is only returned when secret scanning is available
+	SecretScanningNonProviderPatterns SecurityFeature `json:"secret_scanning_non_provider_patterns"`
+}
+
+// SecurityFeature represents the status ("enabled" or "disabled") of a security feature
+type SecurityFeature struct {
+	Status string `json:"status,omitempty"`
 }
 
 // ActionsPermissions represents repository actions permissions
@@ -43,6 +84,40 @@ type Ruleset struct {
 	Conditions   *RulesetConditions `json:"conditions,omitempty"`
 	Rules        []RulesetRule      `json:"rules"`
 	BypassActors []BypassActor      `json:"bypass_actors,omitempty"`
+	// SourceType is "Repository", or "Organization" for a ruleset inherited from the owner
+	SourceType string `json:"source_type,omitempty"`
+}
+
+// Clone returns a deep copy of the ruleset
+func (r *Ruleset) Clone() *Ruleset {
+	if r == nil {
+		return nil
+	}
+	clone := *r
+	clone.Conditions = r.Conditions.Clone()
+	if r.Rules != nil {
+		clone.Rules = make([]RulesetRule, len(r.Rules))
+		for i, rule := range r.Rules {
+			clone.Rules[i] = RulesetRule{
+				Type:       rule.Type,
+				Parameters: cloneMap(rule.Parameters),
+			}
+		}
+	}
+	clone.BypassActors = slices.Clone(r.BypassActors)
+	return &clone
+}
+
+// cloneRulesets returns a deep copy of a list of rulesets
+func cloneRulesets(rulesets []Ruleset) []Ruleset {
+	if rulesets == nil {
+		return nil
+	}
+	clones := make([]Ruleset, len(rulesets))
+	for i := range rulesets {
+		clones[i] = *rulesets[i].Clone()
+	}
+	return clones
 }
 
 // RulesetConditions represents the conditions for a ruleset
@@ -50,6 +125,21 @@ type RulesetConditions struct {
 	RefName *RefNameCondition `json:"ref_name,omitempty"`
 }
 
+// Clone returns a deep copy of the conditions
+func (c *RulesetConditions) Clone() *RulesetConditions {
+	if c == nil {
+		return nil
+	}
+	clone := &RulesetConditions{}
+	if c.RefName != nil {
+		clone.RefName = &RefNameCondition{
+			Include: slices.Clone(c.RefName.Include),
+			Exclude: slices.Clone(c.RefName.Exclude),
+		}
+	}
+	return clone
+}
+
 // RefNameCondition represents branch/tag conditions
 type RefNameCondition struct {
 	Include []string `json:"include"`
@@ -62,6 +152,40 @@ type RulesetRule struct {
 	Parameters map[string]any `json:"parameters,omitempty"`
 }
 
+// ActionsWorkflow represents a workflow registered with GitHub Actions
+// State is "active", "disabled_manually", "disabled_inactivity", "disabled_fork" or "deleted"
+type ActionsWorkflow struct {
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Path  string `json:"path"`
+	State string `json:"state"`
+}
+
+// Environment represents a deployment environment
+type Environment struct {
+	Name            string                      `json:"name"`
+	ProtectionRules []EnvironmentProtectionRule `json:"protection_rules"`
+}
+
+// EnvironmentProtectionRule represents a protection rule of an environment
+// Type is "required_reviewers", "wait_timer" or "branch_policy"
+type EnvironmentProtectionRule struct {
+	Type string `json:"type"`
+}
+
+// Topics represents the topics of a repository
+type Topics struct {
+	Names []string `json:"names"`
+}
+
+// RequiredStatusChecks represents the required status checks of a branch protection
+type RequiredStatusChecks struct {
+	Contexts []string `json:"contexts"`
+	Checks   []struct {
+		Context string `json:"context"`
+	} `json:"checks"`
+}
+
 // BypassActor represents an actor that can bypass the ruleset
 type BypassActor struct {
 	ActorID    int    `json:"actor_id"`
@@ -69,6 +193,107 @@ type BypassActor struct {
 	BypassMode string `json:"bypass_mode"`
 }
 
+// Autolink represents a repository autolink reference
+type Autolink struct {
+	ID             int    `json:"id"`
+	KeyPrefix      string `json:"key_prefix"`
+	URLTemplate    string `json:"url_template"`
+	IsAlphanumeric bool   `json:"is_alphanumeric"`
+}
+
+// AutolinkCreateRequest represents a request to create an autolink reference
+type AutolinkCreateRequest struct {
+	KeyPrefix      string `json:"key_prefix"`
+	URLTemplate    string `json:"url_template"`
+	IsAlphanumeric bool   `json:"is_alphanumeric"`
+}
+
+// Organization represents the organization settings relevant to repository policy
+type Organization struct {
+	Login                        string `json:"login"`
+	DefaultRepositoryPermission  string `json:"default_repository_permission"`
+	MembersCanCreateRepositories bool   `json:"members_can_create_repositories"`
+}
+
+// OrganizationUpdateRequest represents a request to update organization settings
+type OrganizationUpdateRequest struct {
+	DefaultRepositoryPermission  *string `json:"default_repository_permission,omitempty"`
+	MembersCanCreateRepositories *bool   `json:"members_can_create_repositories,omitempty"`
+}
+
+// Label represents an issue/PR label
+type Label struct {
+	Name        string `json:"name"`
+	Color       string `json:"color"`
+	Description string `json:"description"`
+}
+
+// LabelRequest represents a request to create or update a label
+type LabelRequest struct {
+	Name        string `json:"name,omitempty"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Branch represents a branch from the repository branch listing
+type Branch struct {
+	Name      string    `json:"name"`
+	Protected bool      `json:"protected"`
+	Commit    BranchRef `json:"commit"`
+}
+
+// BranchRef represents the commit a branch points to
+type BranchRef struct {
+	SHA string `json:"sha"`
+}
+
+// CustomPropertyValue represents the value of a custom property on a repository. Value is
+// a string, a list of strings for multi-select properties, or nil when unset.
+type CustomPropertyValue struct {
+	PropertyName string `json:"property_name"`
+	Value        any    `json:"value"`
+}
+
+// CheckRun represents a check run reported on a commit, e.g. by a workflow or an external app
+type CheckRun struct {
+	Name       string      `json:"name"`
+	Status     string      `json:"status"`
+	Conclusion string      `json:"conclusion"`
+	App        CheckRunApp `json:"app"`
+}
+
+// CheckRunApp represents the GitHub App that reported a check run
+type CheckRunApp struct {
+	Slug string `json:"slug"`
+	Name string `json:"name"`
+}
+
+// CheckRuns represents a page of check runs for a commit
+type CheckRuns struct {
+	TotalCount int        `json:"total_count"`
+	CheckRuns  []CheckRun `json:"check_runs"`
+}
+
+// Commit represents a repository commit
+type Commit struct {
+	SHA    string       `json:"sha"`
+	Commit CommitDetail `json:"commit"`
+}
+
+// CommitDetail represents the git commit data of a commit
+type CommitDetail struct {
+	Message   string          `json:"message"`
+	Author    CommitSignature `json:"author"`
+	Committer CommitSignature `json:"committer"`
+}
+
+// CommitSignature represents the author or committer of a commit
+type CommitSignature struct {
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Date  time.Time `json:"date"`
+}
+
 // FileContent represents a file's content from GitHub API
 type FileContent struct {
 	Type        string `json:"type"`
@@ -93,14 +318,18 @@ type DependabotConfig struct {
 
 // DependabotUpdate represents a single update configuration
 type DependabotUpdate struct {
-	PackageEcosystem string                     `yaml:"package-ecosystem"`
-	Directory        string                     `yaml:"directory"`
-	Schedule         DependabotSchedule         `yaml:"schedule"`
-	CommitMessage    *DependabotCommitMsg       `yaml:"commit-message,omitempty"`
-	Assignees        []string                   `yaml:"assignees,omitempty"`
-	Reviewers        []string                   `yaml:"reviewers,omitempty"`
-	Labels           []string                   `yaml:"labels,omitempty"`
-	Groups           map[string]DependabotGroup `yaml:"groups,omitempty"`
+	PackageEcosystem string             `yaml:"package-ecosystem"`
+	Directory        string             `yaml:"directory"`
+	Schedule         DependabotSchedule `yaml:"schedule"`
+	// TargetBranch is empty when unset, in which case Dependabot targets the default branch
+	TargetBranch string `yaml:"target-branch,omitempty"`
+	// OpenPullRequestsLimit is nil when unset, in which case Dependabot defaults to 5
+	OpenPullRequestsLimit *int                       `yaml:"open-pull-requests-limit,omitempty"`
+	CommitMessage         *DependabotCommitMsg       `yaml:"commit-message,omitempty"`
+	Assignees             []string                   `yaml:"assignees,omitempty"`
+	Reviewers             []string                   `yaml:"reviewers,omitempty"`
+	Labels                []string                   `yaml:"labels,omitempty"`
+	Groups                map[string]DependabotGroup `yaml:"groups,omitempty"`
 }
 
 // DependabotGroup represents a grouping configuration for Dependabot updates
@@ -145,6 +374,8 @@ type Workflow struct {
 type WorkflowJob struct {
 	Name           string            `yaml:"name,omitempty"`
 	RunsOn         any               `yaml:"runs-on"`
+	Uses           string            `yaml:"uses,omitempty"`
+	With           map[string]any    `yaml:"with,omitempty"`
 	Permissions    any               `yaml:"permissions,omitempty"`
 	TimeoutMinutes int               `yaml:"timeout-minutes,omitempty"`
 	Steps          []WorkflowStep    `yaml:"steps"`
@@ -152,6 +383,8 @@ type WorkflowJob struct {
 	If             string            `yaml:"if,omitempty"`
 	Env            map[string]string `yaml:"env,omitempty"`
 	Strategy       *JobStrategy      `yaml:"strategy,omitempty"`
+	// Environment is an environment name or a map with `name` and `url`
+	Environment any `yaml:"environment,omitempty"`
 }
 
 // JobStrategy represents the strategy for a job
@@ -171,6 +404,7 @@ type WorkflowStep struct {
 	Env              map[string]string `yaml:"env,omitempty"`
 	If               string            `yaml:"if,omitempty"`
 	WorkingDirectory string            `yaml:"working-directory,omitempty"`
+	TimeoutMinutes   any               `yaml:"timeout-minutes,omitempty"`
 }
 
 // RulesetCreateRequest represents a request to create a ruleset
@@ -189,6 +423,9 @@ type RepoUpdateRequest struct {
 	HasWiki                   *bool   `json:"has_wiki,omitempty"`
 	HasProjects               *bool   `json:"has_projects,omitempty"`
 	HasDiscussions            *bool   `json:"has_discussions,omitempty"`
+	HasDownloads              *bool   `json:"has_downloads,omitempty"`
+	AllowForking              *bool   `json:"allow_forking,omitempty"`
+	WebCommitSignoffRequired  *bool   `json:"web_commit_signoff_required,omitempty"`
 	PullRequestCreationPolicy *string `json:"pull_request_creation_policy,omitempty"`
 	AllowMergeCommit          *bool   `json:"allow_merge_commit,omitempty"`
 	AllowSquashMerge          *bool   `json:"allow_squash_merge,omitempty"`
@@ -196,4 +433,42 @@ type RepoUpdateRequest struct {
 	AllowAutoMerge            *bool   `json:"allow_auto_merge,omitempty"`
 	DeleteBranchOnMerge       *bool   `json:"delete_branch_on_merge,omitempty"`
 	AllowUpdateBranch         *bool   `json:"allow_update_branch,omitempty"`
+	SquashMergeCommitMessage  *string `json:"squash_merge_commit_message,omitempty"`
+
+	SecurityAndAnalysis *SecurityAndAnalysisUpdate `json:"security_and_analysis,omitempty"`
+}
+
+// SecurityAndAnalysisUpdate represents a request to update security and analysis features
+type SecurityAndAnalysisUpdate struct {
+	DependencyGraph                   *SecurityFeature `json:"dependency_graph,omitempty"`
+	AdvancedSecurity                  *SecurityFeature `json:"advanced_security,omitempty"`
+	SecretScanningNonProviderPatterns *SecurityFeature `json:"secret_scanning_non_provider_patterns,omitempty"`
+}
+
+// cloneMap returns a deep copy of a decoded JSON object
+func cloneMap(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	clone := maps.Clone(m)
+	for k, v := range clone {
+		clone[k] = cloneValue(v)
+	}
+	return clone
+}
+
+// cloneValue returns a deep copy of a decoded JSON value
+func cloneValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		return cloneMap(val)
+	case []any:
+		clone := make([]any, len(val))
+		for i, item := range val {
+			clone[i] = cloneValue(item)
+		}
+		return clone
+	default:
+		return val
+	}
 }
@@ -0,0 +1,100 @@
+package github
+
+// Triggers returns the workflow's `on:` triggers normalized to a map of event name
+// to event configuration. The string (`on: push`) and list (`on: [push, pull_request]`)
+// forms produce entries with a nil configuration.
+func (w *Workflow) Triggers() map[string]any {
+	triggers := make(map[string]any)
+
+	switch on := w.On.(type) {
+	case string:
+		triggers[on] = nil
+	case []any:
+		for _, event := range on {
+			if name, ok := event.(string); ok {
+				triggers[name] = nil
+			}
+		}
+	case map[string]any:
+		for name, cfg := range on {
+			triggers[name] = cfg
+		}
+	}
+
+	return triggers
+}
+
+// WorkflowCallInputs returns the inputs declared by the workflow's `workflow_call` trigger,
+// mapped to whether each is required. ok is false if the workflow is not reusable.
+func (w *Workflow) WorkflowCallInputs() (inputs map[string]bool, ok bool) {
+	cfg, ok := w.Triggers()["workflow_call"]
+	if !ok {
+		return nil, false
+	}
+
+	inputs = make(map[string]bool)
+	m, _ := cfg.(map[string]any)
+	declared, _ := m["inputs"].(map[string]any)
+	for name, input := range declared {
+		spec, _ := input.(map[string]any)
+		required, _ := spec["required"].(bool)
+		inputs[name] = required
+	}
+	return inputs, true
+}
+
+// Schedules returns the cron expressions of the workflow's `schedule` trigger
+func (w *Workflow) Schedules() []string {
+	entries, ok := w.Triggers()["schedule"].([]any)
+	if !ok {
+		return nil
+	}
+
+	var crons []string
+	for _, entry := range entries {
+		m, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		if cron, ok := m["cron"].(string); ok {
+			crons = append(crons, cron)
+		}
+	}
+	return crons
+}
+
+// RunnerLabels returns the runner labels of the job's `runs-on`, which may be a single
+// label, a list of labels, or a runner group with `labels`. Expressions are returned as-is.
+func (j *WorkflowJob) RunnerLabels() []string {
+	runsOn := j.RunsOn
+	if m, ok := runsOn.(map[string]any); ok {
+		runsOn = m["labels"]
+	}
+
+	switch v := runsOn.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var labels []string
+		for _, label := range v {
+			if s, ok := label.(string); ok {
+				labels = append(labels, s)
+			}
+		}
+		return labels
+	}
+	return nil
+}
+
+// EnvironmentName returns the name of the job's deployment environment, or "" if the
+// job does not deploy to one
+func (j *WorkflowJob) EnvironmentName() string {
+	switch env := j.Environment.(type) {
+	case string:
+		return env
+	case map[string]any:
+		name, _ := env["name"].(string)
+		return name
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+package github
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestRunnerLabels(t *testing.T) {
+	tests := []struct {
+		name   string
+		runsOn any
+		want   []string
+	}{
+		{"single label", "ubuntu-24.04", []string{"ubuntu-24.04"}},
+		{"label list", []any{"self-hosted", "linux"}, []string{"self-hosted", "linux"}},
+		{"runner group", map[string]any{"group": "large", "labels": "ubuntu-24.04-16core"}, []string{"ubuntu-24.04-16core"}},
+		{"runner group list", map[string]any{"group": "large", "labels": []any{"linux", "x64"}}, []string{"linux", "x64"}},
+		{"runner group without labels", map[string]any{"group": "large"}, nil},
+		{"reusable workflow", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := WorkflowJob{RunsOn: tt.runsOn}
+			if got := job.RunnerLabels(); !slices.Equal(got, tt.want) {
+				t.Errorf("RunnerLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnvironmentName(t *testing.T) {
+	tests := []struct {
+		name        string
+		environment any
+		want        string
+	}{
+		{"name", "production", "production"},
+		{"map", map[string]any{"name": "staging", "url": "https://staging.example.com"}, "staging"},
+		{"map without name", map[string]any{"url": "https://example.com"}, ""},
+		{"none", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := WorkflowJob{Environment: tt.environment}
+			if got := job.EnvironmentName(); got != tt.want {
+				t.Errorf("EnvironmentName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWorkflowCallInputs(t *testing.T) {
+	tests := []struct {
+		name   string
+		on     any
+		want   map[string]bool
+		wantOK bool
+	}{
+		{"not reusable", "push", nil, false},
+		{"no inputs", []any{"workflow_call"}, map[string]bool{}, true},
+		{
+			"inputs",
+			map[string]any{"workflow_call": map[string]any{"inputs": map[string]any{
+				"environment": map[string]any{"type": "string", "required": true},
+				"dry-run":     map[string]any{"type": "boolean", "default": false},
+			}}},
+			map[string]bool{"environment": true, "dry-run": false},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := Workflow{On: tt.on}
+			got, ok := wf.WorkflowCallInputs()
+			if ok != tt.wantOK || !maps.Equal(got, tt.want) {
+				t.Errorf("WorkflowCallInputs() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -2,9 +2,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/cli/go-gh/v2/pkg/prompter"
 	"github.com/cli/go-gh/v2/pkg/repository"
@@ -15,15 +22,45 @@ import (
 	"github.com/sethrylan/gh-repolint/config"
 	"github.com/sethrylan/gh-repolint/fix"
 	"github.com/sethrylan/gh-repolint/github"
+	"github.com/sethrylan/gh-repolint/report"
 )
 
 var (
 	version = "dev"
 
-	configFlag  string
-	fixFlag     bool
-	skipFlag    string
-	verboseFlag bool
+	configFlag           string
+	ownerConfigRefFlag   string
+	requireOwnerFlag     bool
+	fixFlag              bool
+	fixOnlyFlag          bool
+	fixInteractiveFlag   bool
+	fixableOnlyFlag      bool
+	verifyFixesFlag      bool
+	continueOnErrorFlag  bool
+	refFlag              string
+	initOutputFlag       string
+	checkLinksFlag       bool
+	skipFlag             string
+	verboseFlag          bool
+	includeArchivedFlag  bool
+	strictFlag           bool
+	formatFlag           string
+	colorFlag            string
+	allowDestructiveFlag bool
+	allowExecFlag        bool
+	summaryOnlyFlag      bool
+	templateFlag         string
+	templateFileFlag     string
+	noColorFlag          bool
+	saveResultsFlag      string
+	maxIssuesFlag        int
+)
+
+// Values accepted by --color
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
 )
 
 func main() {
@@ -36,10 +73,31 @@ configuration against organizational standards defined in .repolint.yml`,
 		SilenceUsage: true,
 	}
 
-	rootCmd.PersistentFlags().StringVar(&configFlag, "config", "", "Path to config file (bypasses normal discovery)")
+	rootCmd.PersistentFlags().StringVar(&configFlag, "config", "", "Path to config file, directory of *.yaml config fragments, or - to read from stdin (bypasses normal discovery)")
+	rootCmd.PersistentFlags().StringVar(&ownerConfigRefFlag, "owner-config-ref", "", "Read the owner config at this branch, tag or commit of the <owner>/<owner> repository")
+	rootCmd.PersistentFlags().BoolVar(&requireOwnerFlag, "require-owner-config", false, "Fail if the owner has no config in its <owner>/<owner> repository")
+	rootCmd.PersistentFlags().StringVar(&colorFlag, "color", colorAuto, "Colorize output: auto, always or never")
+	rootCmd.PersistentFlags().BoolVar(&noColorFlag, "no-color", false, "Disable colored output (same as --color=never)")
+	rootCmd.PersistentFlags().BoolVar(&strictFlag, "strict", false, "Treat configuration warnings as errors")
 	rootCmd.Flags().BoolVar(&fixFlag, "fix", false, "Attempt to automatically fix issues")
+	rootCmd.Flags().BoolVar(&fixOnlyFlag, "fix-only", false, "Like --fix, but only fail if a fixable issue could not be fixed")
+	rootCmd.Flags().BoolVar(&fixInteractiveFlag, "fix-interactive", false, "Like --fix, but confirm each fix before it is applied, deferring declined fixes")
+	rootCmd.Flags().BoolVar(&fixableOnlyFlag, "fixable-only", false, "Report only fixable issues, previewing what --fix would attempt without writing anything")
+	rootCmd.Flags().BoolVar(&verifyFixesFlag, "verify-fixes", false, "Re-read each setting after --fix updates it, and fail the fix if the change did not take effect")
+	rootCmd.Flags().BoolVar(&allowDestructiveFlag, "allow-destructive", false, "Allow --fix to apply destructive fixes such as deleting branches")
 	rootCmd.Flags().StringVar(&skipFlag, "skip", "", "Comma-separated list of checks to skip")
+	rootCmd.Flags().BoolVar(&continueOnErrorFlag, "continue-on-error", false, "Keep running the remaining checks when a check fails, and report its error at the end")
 	rootCmd.Flags().BoolVarP(&verboseFlag, "verbose", "v", false, "Enable verbose output")
+	rootCmd.Flags().StringVar(&formatFlag, "format", report.FormatText, "Output format: "+strings.Join(report.Formats, ", "))
+	rootCmd.Flags().StringVar(&templateFlag, "template", "", "Go text/template for --format template")
+	rootCmd.Flags().StringVar(&templateFileFlag, "template-file", "", "File containing a Go text/template for --format template")
+	rootCmd.Flags().IntVar(&maxIssuesFlag, "max-issues", 0, "Report at most this many issues with --format text or github (0 for no limit); the exit code still reflects every issue")
+	rootCmd.Flags().BoolVar(&summaryOnlyFlag, "summary-only", false, "Write a single line of issue counts instead of the issues")
+	rootCmd.Flags().StringVar(&saveResultsFlag, "save-results", "", "Write the issues found to this JSON file, for the status command")
+	rootCmd.Flags().StringVar(&refFlag, "ref", "", "Read workflow and file contents at this commit, branch or tag instead of the working tree")
+	rootCmd.Flags().BoolVar(&checkLinksFlag, "check-links", false, "Request URLs in repository settings (e.g. the homepage) to confirm they resolve")
+	rootCmd.Flags().BoolVar(&allowExecFlag, "allow-exec", false, "Allow custom checks to run the external commands in the configuration")
+	rootCmd.Flags().BoolVar(&includeArchivedFlag, "include-archived", false, "Run read-only checks on archived repositories")
 
 	// Config subcommand
 	configCmd := &cobra.Command{
@@ -47,14 +105,30 @@ configuration against organizational standards defined in .repolint.yml`,
 		Short: "Validate and display the merged configuration",
 		RunE:  runConfig,
 	}
+	configValidateCmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Resolve every reference in the merged configuration",
+		RunE:  runConfigValidate,
+	}
+	configCmd.AddCommand(configValidateCmd)
 	rootCmd.AddCommand(configCmd)
 
+	// Org subcommand
+	rootCmd.AddCommand(newOrgCmd())
+
+	// Status subcommand
+	rootCmd.AddCommand(newStatusCmd())
+
+	// Doctor subcommand
+	rootCmd.AddCommand(newDoctorCmd())
+
 	// Init subcommand
 	initCmd := &cobra.Command{
 		Use:   "init",
 		Short: "Interactive wizard to generate a starter .repolint.yaml",
 		RunE:  runInit,
 	}
+	initCmd.Flags().StringVarP(&initOutputFlag, "output", "o", "", "Write the config to this .yaml/.yml file, or into this directory (default: .repolint.yaml)")
 	rootCmd.AddCommand(initCmd)
 
 	// Version subcommand
@@ -72,9 +146,65 @@ configuration against organizational standards defined in .repolint.yml`,
 	}
 }
 
-func runLint(cmd *cobra.Command, args []string) error {
+func runLint(cmd *cobra.Command, args []string) (runErr error) {
 	ctx := context.Background()
 
+	// --fix-only implies --fix
+	if fixOnlyFlag {
+		fixFlag = true
+	}
+
+	// --fix-interactive implies --fix, and needs a terminal to prompt on
+	if fixInteractiveFlag {
+		if !term.IsTerminal(os.Stdin) || !term.IsTerminal(os.Stdout) {
+			return errors.New("--fix-interactive requires a terminal (use --fix to apply every fix without prompting)")
+		}
+		fixFlag = true
+	}
+
+	// Fixes write to the working tree, which is not what --ref checks
+	if fixFlag && refFlag != "" {
+		return errors.New("--fix cannot be combined with --ref")
+	}
+	if fixFlag && fixableOnlyFlag {
+		return errors.New("--fix cannot be combined with --fixable-only")
+	}
+	if fixFlag && summaryOnlyFlag {
+		return errors.New("--fix cannot be combined with --summary-only")
+	}
+
+	useColor, err := resolveColor()
+	if err != nil {
+		return err
+	}
+
+	var formatter report.Formatter
+	if formatFlag == report.FormatTemplate {
+		formatter, err = report.NewTemplateFormatter(templateFlag, templateFileFlag)
+	} else {
+		formatter, err = report.NewFormatter(formatFlag, useColor)
+	}
+	if err != nil {
+		return err
+	}
+	if maxIssuesFlag < 0 {
+		return fmt.Errorf("invalid --max-issues: %d (must be 0 or greater)", maxIssuesFlag)
+	}
+	switch f := formatter.(type) {
+	case *report.TextFormatter:
+		f.MaxIssues = maxIssuesFlag
+	case *report.GitHubFormatter:
+		f.MaxIssues = maxIssuesFlag
+	default:
+		// Template and JSON output are meant to be complete
+		if maxIssuesFlag > 0 {
+			return fmt.Errorf("--max-issues cannot be combined with --format %s", formatFlag)
+		}
+	}
+	if summaryOnlyFlag && formatFlag == report.FormatTemplate {
+		return errors.New("--summary-only cannot be combined with --format template (use .Summary in the template)")
+	}
+
 	// Get current repository
 	repo, err := repository.Current()
 	if err != nil {
@@ -86,89 +216,317 @@ func runLint(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to create GitHub client: %w", err)
 	}
+	client.SetIncludeArchived(includeArchivedFlag)
+	client.SetRef(refFlag)
 
 	// Check permissions
 	if permErr := client.CheckPermissions(); permErr != nil {
+		if errors.Is(permErr, github.ErrRepositoryArchived) {
+			return fmt.Errorf("%w (use --include-archived to run read-only checks)", permErr)
+		}
 		return permErr
 	}
 
-	// Load configuration
-	loader := config.NewLoader(client)
-	var loadedConfig *config.LoadedConfig
-	if configFlag != "" {
-		loadedConfig, err = loader.LoadFromFile(configFlag)
-	} else {
-		loadedConfig, err = loader.Load()
+	// Archived repositories are read-only, so fixes cannot be applied
+	if fixFlag {
+		ghRepo, repoErr := client.GetRepository()
+		if repoErr != nil {
+			return fmt.Errorf("failed to fetch repository: %w", repoErr)
+		}
+		if ghRepo.Archived {
+			return fmt.Errorf("cannot apply fixes: %s/%s: %w", repo.Owner, repo.Name, github.ErrRepositoryArchived)
+		}
 	}
+
+	// Load configuration
+	loadedConfig, err := loadConfig(client)
 	if err != nil {
 		return fmt.Errorf("configuration error: %w", err)
 	}
+	if err := checkConfigWarnings(loadedConfig.Config); err != nil {
+		return err
+	}
 
 	// Parse skip flag
-	var skip []string
-	if skipFlag != "" {
-		skip = strings.Split(skipFlag, ",")
-		for i := range skip {
-			skip[i] = strings.TrimSpace(skip[i])
-		}
-	}
+	skip := parseSkip(skipFlag)
 
 	// Run checks
 	runner := checks.NewRunner(client, loadedConfig.Config, verboseFlag)
+	if err := checks.ValidateCheckNames(skip, runner.GetCheckNames()); err != nil {
+		return fmt.Errorf("invalid --skip: %w", err)
+	}
+	runner.SetCheckLinks(checkLinksFlag)
+	runner.SetAllowExec(allowExecFlag)
+	runner.SetContinueOnError(continueOnErrorFlag)
+	start := time.Now()
 	issues, err := runner.Run(ctx, skip)
 	if err != nil {
 		return fmt.Errorf("check failed: %w", err)
 	}
 
-	// If no issues, report success
-	if len(issues) == 0 {
-		printSuccess(runner, verboseFlag)
+	// With --continue-on-error, checks that failed to run are reported after the results
+	errored := erroredChecks(runner.GetCheckStatuses())
+	defer func() {
+		for _, status := range errored {
+			fmt.Fprintf(os.Stderr, "Error: check %s failed: %s\n", status.Name, status.Error)
+		}
+		if len(errored) > 0 {
+			runErr = errors.Join(runErr, fmt.Errorf("%d check(s) failed to run", len(errored)))
+		}
+	}()
+
+	if saveResultsFlag != "" {
+		results := report.NewResults(version)
+		results.Add(repo.Owner+"/"+repo.Name, runner.GetCheckStatuses(), issues, nil)
+		if err := report.SaveResults(saveResultsFlag, results); err != nil {
+			return err
+		}
+	}
+
+	// Preview the scope of --fix from the Fixable flag alone; no fixer is constructed
+	if fixableOnlyFlag {
+		total := len(issues)
+		issues = checks.FixableIssues(issues)
+		fmt.Fprintln(os.Stderr, fixablePreview(total, issues, allowDestructiveFlag))
+	}
+
+	// Summaries are written whether or not issues were found
+	if summaryOnlyFlag {
+		summary := report.NewSummary(repo.Owner+"/"+repo.Name, issues, time.Since(start))
+		if err := report.WriteSummary(os.Stdout, formatFlag, summary); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+		if errorCount := checks.ErrorCount(issues); errorCount > 0 {
+			return fmt.Errorf("found %d issue(s)", errorCount)
+		}
 		return nil
 	}
 
-	// If --fix, attempt to fix issues
-	if fixFlag {
-		return handleFix(ctx, client, loadedConfig.Config, issues)
+	// Templates are executed, and JSON written, even without issues
+	_, isJSON := formatter.(*report.JSONFormatter)
+	if tf, ok := formatter.(*report.TemplateFormatter); ok {
+		tf.Repo = repo.Owner + "/" + repo.Name
+		tf.Checks = runner.GetCheckStatuses()
+		tf.Duration = time.Since(start)
+	} else if len(issues) == 0 && !isJSON {
+		if !fixableOnlyFlag && len(errored) == 0 {
+			printSuccess(runner, verboseFlag)
+		}
+		return nil
+	}
+
+	// If --fix, attempt to fix issues; JSON output is a (possibly empty) list of fix results
+	if fixFlag && (len(issues) > 0 || isJSON) {
+		return handleFix(ctx, client, loadedConfig.Config, issues, formatter)
 	}
 
 	// Report issues
-	printIssues(issues)
-	return fmt.Errorf("found %d issue(s)", len(issues))
+	if err := formatter.Format(os.Stdout, issues); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	// Warnings and informational issues are reported but do not fail the run
+	if errorCount := checks.ErrorCount(issues); errorCount > 0 {
+		return fmt.Errorf("found %d issue(s)", errorCount)
+	}
+	return nil
+}
+
+// erroredChecks returns the statuses of the checks that failed to run
+func erroredChecks(statuses []checks.CheckStatus) []checks.CheckStatus {
+	var errored []checks.CheckStatus
+	for _, status := range statuses {
+		if status.Error != "" {
+			errored = append(errored, status)
+		}
+	}
+	return errored
+}
+
+// fixablePreview describes what --fix would attempt for the fixable issues out of total
+// found, by check type. Destructive fixes are only counted with --allow-destructive.
+func fixablePreview(total int, fixable []checks.Issue, allowDestructive bool) string {
+	if len(fixable) == 0 {
+		return fmt.Sprintf("--fix would attempt no fixes (%d issue(s) found, none fixable)", total)
+	}
+
+	attempted := 0
+	destructive := 0
+	byType := make(map[string]int)
+	for _, issue := range fixable {
+		if issue.Destructive && !allowDestructive {
+			destructive++
+			continue
+		}
+		attempted++
+		byType[string(issue.Type)]++
+	}
+
+	types := make([]string, 0, len(byType))
+	for _, checkType := range slices.Sorted(maps.Keys(byType)) {
+		types = append(types, fmt.Sprintf("%s (%d)", checkType, byType[checkType]))
+	}
+
+	preview := fmt.Sprintf("--fix would attempt %d of %d issue(s)", attempted, total)
+	if len(types) > 0 {
+		preview += ": " + strings.Join(types, ", ")
+	}
+	if destructive > 0 {
+		preview += fmt.Sprintf("; %d destructive fix(es) also need --allow-destructive", destructive)
+	}
+	return preview
+}
+
+// resolveColor decides whether output is colorized from --no-color, --color and the
+// NO_COLOR convention (https://no-color.org), falling back to terminal detection
+func resolveColor() (bool, error) {
+	if noColorFlag {
+		return false, nil
+	}
+
+	switch colorFlag {
+	case colorAlways:
+		return true, nil
+	case colorNever:
+		return false, nil
+	case colorAuto:
+		if os.Getenv("NO_COLOR") != "" {
+			return false, nil
+		}
+		return term.FromEnv().IsTerminalOutput(), nil
+	default:
+		return false, fmt.Errorf("invalid --color value %q (must be %s, %s or %s)", colorFlag, colorAuto, colorAlways, colorNever)
+	}
 }
 
-func handleFix(ctx context.Context, client *github.Client, cfg *config.Config, issues []checks.Issue) error {
+// checkConfigWarnings writes post-merge validation warnings for the effective config
+// to stderr. With --strict, any warning is returned as an error.
+func checkConfigWarnings(cfg *config.Config) error {
+	warnings := config.Validate(cfg)
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+	if strictFlag && len(warnings) > 0 {
+		return fmt.Errorf("configuration error: %d warning(s) with --strict", len(warnings))
+	}
+	return nil
+}
+
+// parseSkip splits the comma-separated --skip value into check names
+func parseSkip(value string) []string {
+	if value == "" {
+		return nil
+	}
+	skip := strings.Split(value, ",")
+	for i := range skip {
+		skip[i] = strings.TrimSpace(skip[i])
+	}
+	return skip
+}
+
+// loadConfig loads configuration from --config (a file path, or "-" for stdin)
+// or, when --config is not set, via normal owner/repo discovery
+func loadConfig(client *github.Client) (*config.LoadedConfig, error) {
+	if requireOwnerFlag && configFlag != "" {
+		return nil, errors.New("--require-owner-config cannot be combined with --config")
+	}
+	loader := newLoader(client)
+	switch configFlag {
+	case "":
+		return loader.Load()
+	case config.StdinConfigPath:
+		return loader.LoadFromReader(os.Stdin, "stdin")
+	default:
+		if info, err := os.Stat(configFlag); err == nil && info.IsDir() {
+			return loader.LoadFromDir(configFlag)
+		}
+		return loader.LoadFromFile(configFlag)
+	}
+}
+
+// newLoader creates a config loader honoring --owner-config-ref
+func newLoader(client *github.Client) *config.Loader {
+	loader := config.NewLoader(client)
+	loader.SetOwnerConfigRef(ownerConfigRefFlag)
+	loader.SetRequireOwnerConfig(requireOwnerFlag)
+	return loader
+}
+
+// handleFix fixes the issues and reports the results, through formatter when it writes
+// fix results itself and as text otherwise
+func handleFix(ctx context.Context, client *github.Client, cfg *config.Config, issues []checks.Issue, formatter report.Formatter) error {
 	orchestrator := fix.NewOrchestrator(client, cfg, verboseFlag)
+	orchestrator.SetAllowDestructive(allowDestructiveFlag)
+	orchestrator.SetVerify(verifyFixesFlag)
+	if fixInteractiveFlag {
+		p := prompter.New(os.Stdin, os.Stdout, os.Stderr)
+		orchestrator.SetConfirm(func(issue checks.Issue) (bool, error) {
+			prompt := fmt.Sprintf("Fix [%s] %s?", issue.Name, issue.Message)
+			if issue.Destructive {
+				prompt = fmt.Sprintf("Fix [%s] %s? (destructive)", issue.Name, issue.Message)
+			}
+			return p.Confirm(prompt, !issue.Destructive)
+		})
+	}
 	results, err := orchestrator.Fix(ctx, issues)
 	if err != nil {
 		return fmt.Errorf("fix failed: %w", err)
 	}
 
-	// Report results
+	// Report results; structured formats replace the text report
+	var out io.Writer = os.Stdout
+	fixFormatter, structured := formatter.(report.FixFormatter)
+	if structured {
+		out = io.Discard
+		if err := fixFormatter.FormatFixes(os.Stdout, results); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	}
+
 	fixedCount := 0
+	deferredCount := 0
 	unfixedIssues := []checks.Issue{}
 
 	for _, result := range results {
 		if result.Fixed {
 			fixedCount++
-			fmt.Printf("  Fixed: [%s] %s\n", result.Issue.Name, result.Issue.Message)
+			fmt.Fprintf(out, "  Fixed: [%s] %s\n", result.Issue.Name, result.Issue.Message)
+		} else if errors.Is(result.Error, fix.ErrFixDeferred) {
+			deferredCount++
+			fmt.Fprintf(out, "  Deferred: [%s] %s\n", result.Issue.Name, result.Issue.Message)
+		} else if result.Issue.Severity != checks.SeverityError {
+			// Warnings and informational issues left unfixed do not fail the run
+			fmt.Fprintf(out, "  Not fixed (%s): [%s] %s\n", result.Issue.Severity, result.Issue.Name, result.Issue.Message)
+		} else if fixOnlyFlag && !result.Issue.Fixable {
+			// With --fix-only, non-fixable issues are reported but do not fail the run
+			fmt.Fprintf(out, "  Warning: [%s] %s (not fixable)\n", result.Issue.Name, result.Issue.Message)
 		} else {
 			unfixedIssues = append(unfixedIssues, result.Issue)
 			if result.Error != nil {
-				fmt.Printf("  Could not fix: [%s] %s (%s)\n", result.Issue.Name, result.Issue.Message, result.Error)
+				fmt.Fprintf(out, "  Could not fix: [%s] %s (%s)\n", result.Issue.Name, result.Issue.Message, result.Error)
 			} else {
-				fmt.Printf("  Could not fix: [%s] %s (requires manual intervention)\n", result.Issue.Name, result.Issue.Message)
+				fmt.Fprintf(out, "  Could not fix: [%s] %s (requires manual intervention)\n", result.Issue.Name, result.Issue.Message)
 			}
 		}
 	}
 
-	fmt.Println()
-	fmt.Printf("Fixed %d of %d issues\n", fixedCount, len(issues))
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "Fixed %d of %d issues\n", fixedCount, len(issues))
 
 	if len(unfixedIssues) > 0 {
+		if fixOnlyFlag {
+			return fmt.Errorf("%d fixable issue(s) could not be fixed", len(unfixedIssues))
+		}
 		return fmt.Errorf("%d issue(s) require manual intervention", len(unfixedIssues))
 	}
+	if deferredCount > 0 {
+		return fmt.Errorf("%d fix(es) deferred", deferredCount)
+	}
 
-	fmt.Println("All checks passed")
+	if fixedCount < len(issues) {
+		fmt.Fprintln(out, "All fixable issues fixed")
+		return nil
+	}
+	fmt.Fprintln(out, "All checks passed")
 	return nil
 }
 
@@ -186,23 +544,6 @@ func printSuccess(runner *checks.Runner, verbose bool) {
 	}
 }
 
-func printIssues(issues []checks.Issue) {
-	fmt.Println("Repository validation failed:")
-	fixableCount := 0
-	for _, issue := range issues {
-		fixable := ""
-		if issue.Fixable {
-			fixable = " (fixable)"
-			fixableCount++
-		}
-		fmt.Printf("  [%s] %s%s\n", issue.Name, issue.Message, fixable)
-	}
-	fmt.Println()
-	if fixableCount > 0 {
-		fmt.Printf("Run with --fix to automatically fix %d issue(s)\n", fixableCount)
-	}
-}
-
 func runConfig(cmd *cobra.Command, args []string) error {
 	// Get current repository
 	repo, err := repository.Current()
@@ -217,26 +558,24 @@ func runConfig(cmd *cobra.Command, args []string) error {
 	}
 
 	// Load configuration
-	loader := config.NewLoader(client)
-	var loadedConfig *config.LoadedConfig
-	if configFlag != "" {
-		loadedConfig, err = loader.LoadFromFile(configFlag)
-	} else {
-		loadedConfig, err = loader.Load()
-	}
+	loadedConfig, err := loadConfig(client)
 	if err != nil {
 		return fmt.Errorf("configuration error: %w", err)
 	}
+	if err := checkConfigWarnings(loadedConfig.Config); err != nil {
+		return err
+	}
 
-	// Check if terminal supports colors
-	terminal := term.FromEnv()
-	useColor := terminal.IsTerminalOutput()
+	useColor, err := resolveColor()
+	if err != nil {
+		return err
+	}
 
-	// Create reference validator
-	validator := func(reference string) error {
+	// Resolve references up front and in parallel, so the display does not block on each one
+	validator := config.PrevalidateReferences(loadedConfig.Config, func(reference string) error {
 		_, err := github.ResolveReferenceFile(reference, client)
 		return err
-	}
+	}, config.DefaultReferenceConcurrency)
 
 	// Display configuration with validation
 	result := config.DisplayConfig(os.Stdout, loadedConfig, useColor, validator)
@@ -250,6 +589,62 @@ func runConfig(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	// Get current repository
+	repo, err := repository.Current()
+	if err != nil {
+		return fmt.Errorf("failed to get current repository: %w", err)
+	}
+
+	// Create GitHub client
+	client, err := github.NewClient(repo.Owner, repo.Name, verboseFlag)
+	if err != nil {
+		return fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
+	// Load configuration
+	loadedConfig, err := loadConfig(client)
+	if err != nil {
+		return fmt.Errorf("configuration error: %w", err)
+	}
+	if err := checkConfigWarnings(loadedConfig.Config); err != nil {
+		return err
+	}
+
+	validator := func(reference string) error {
+		_, err := github.ResolveReferenceFile(reference, client)
+		return err
+	}
+
+	refs := config.CollectReferences(loadedConfig.Config)
+	results := config.ValidateReferences(refs, validator, config.DefaultReferenceConcurrency)
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "REFERENCE\tDECLARED IN\tSTATUS")
+	broken := 0
+	for _, r := range results {
+		status := "OK"
+		if r.Err != nil {
+			status = "BROKEN"
+			broken++
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Value, r.Path, status)
+	}
+	_ = tw.Flush()
+
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", r.Value, r.Err)
+		}
+	}
+
+	if broken > 0 {
+		return fmt.Errorf("found %d broken reference(s)", broken)
+	}
+
+	return nil
+}
+
 func runInit(cmd *cobra.Command, args []string) error {
 	// Get current repository for owner info
 	repo, err := repository.Current()
@@ -257,9 +652,18 @@ func runInit(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get current repository: %w", err)
 	}
 
-	// Check if config already exists (check all supported extensions)
+	outputPath, err := resolveInitOutput(initOutputFlag)
+	if err != nil {
+		return err
+	}
+
+	// Check if config already exists (check all supported extensions, or only --output)
+	candidates := config.ConfigFileNames
+	if initOutputFlag != "" {
+		candidates = []string{outputPath}
+	}
 	var existingConfig string
-	for _, name := range config.ConfigFileNames {
+	for _, name := range candidates {
 		if _, statErr := os.Stat(name); statErr == nil {
 			existingConfig = name
 			break
@@ -352,15 +756,41 @@ func runInit(cmd *cobra.Command, args []string) error {
 	// Generate YAML
 	content := generateConfigYAML(cfg)
 
-	// Write file (always use the first/default config filename)
-	if err := os.WriteFile(config.ConfigFileNames[0], []byte(content), 0600); err != nil {
+	// Write file
+	if dir := filepath.Dir(outputPath); dir != "." {
+		if err := os.MkdirAll(dir, 0750); err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(outputPath, []byte(content), 0600); err != nil {
 		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
-	fmt.Printf("Created %s\n", config.ConfigFileNames[0])
+	fmt.Printf("Created %s\n", outputPath)
 	return nil
 }
 
+// resolveInitOutput returns the file init writes to. An empty output is the default
+// config filename; a directory (existing, or written with a trailing separator) gets
+// the default filename inside it; any other path must end in .yaml or .yml.
+func resolveInitOutput(output string) (string, error) {
+	if output == "" {
+		return config.ConfigFileNames[0], nil
+	}
+
+	info, err := os.Stat(output)
+	if (err == nil && info.IsDir()) || strings.HasSuffix(output, string(filepath.Separator)) {
+		return filepath.Join(output, config.ConfigFileNames[0]), nil
+	}
+
+	switch filepath.Ext(output) {
+	case ".yaml", ".yml":
+		return output, nil
+	default:
+		return "", fmt.Errorf("invalid --output %q: must be a directory or end in .yaml or .yml", output)
+	}
+}
+
 func promptSettingsConfig(p *prompter.Prompter) (*config.SettingsConfig, error) {
 	cfg := &config.SettingsConfig{}
 
@@ -432,6 +862,11 @@ func promptSettingsConfig(p *prompter.Prompter) (*config.SettingsConfig, error)
 		return nil, err
 	}
 	cfg.Dependabot.Alerts = &alerts
+	if alerts {
+		// Alerts are not raised without the dependency graph
+		dependencyGraph := true
+		cfg.Dependabot.DependencyGraph = &dependencyGraph
+	}
 
 	securityUpdates, err := p.Confirm("Enable Dependabot security updates?", true)
 	if err != nil {
@@ -607,6 +1042,9 @@ func generateConfigYAML(cfg *config.Config) string {
 			if d.SecurityUpdates != nil {
 				fmt.Fprintf(&sb, "      security_updates: %t\n", *d.SecurityUpdates)
 			}
+			if d.DependencyGraph != nil {
+				fmt.Fprintf(&sb, "      dependency_graph: %t\n", *d.DependencyGraph)
+			}
 		}
 		sb.WriteString("\n")
 	}
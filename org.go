@@ -0,0 +1,401 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/gobwas/glob"
+	"github.com/spf13/cobra"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/config"
+	"github.com/sethrylan/gh-repolint/fix"
+	"github.com/sethrylan/gh-repolint/github"
+	"github.com/sethrylan/gh-repolint/report"
+)
+
+var (
+	excludeRepoFlags    []string
+	excludeForksFlag    bool
+	excludeArchivedFlag bool
+	parallelReposFlag   int
+	fixOrgFlag          bool
+)
+
+// defaultParallelRepos is the default org scan concurrency, kept low to stay within rate limits
+const defaultParallelRepos = 4
+
+// repoResult is the outcome of linting one repository in an org scan
+type repoResult struct {
+	repo     github.Repository
+	statuses []checks.CheckStatus
+	issues   []checks.Issue
+	err      error
+	duration time.Duration
+}
+
+// Exclusion reasons reported in the org scan summary
+const (
+	excludedArchived = "archived"
+	excludedFork     = "fork"
+	excludedByName   = "--exclude-repo"
+)
+
+func newOrgCmd() *cobra.Command {
+	orgCmd := &cobra.Command{
+		Use:   "org <owner>",
+		Short: "Lint every repository of an organization or user",
+		Long: `Lint every repository of an organization or user against the owner-level
+configuration (or --config). Repositories are not checked out, so checks that read
+the local working tree (actions, files, funding, dependabot, custom) are skipped.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runOrg,
+	}
+
+	orgCmd.Flags().StringArrayVar(&excludeRepoFlags, "exclude-repo", nil, "Exclude repositories whose name matches a glob (repeatable)")
+	orgCmd.Flags().BoolVar(&excludeForksFlag, "exclude-forks", false, "Exclude forked repositories")
+	orgCmd.Flags().BoolVar(&excludeArchivedFlag, "exclude-archived", false, "Exclude archived repositories")
+	orgCmd.Flags().IntVar(&parallelReposFlag, "parallel-repos", defaultParallelRepos, "Number of repositories to lint concurrently")
+	orgCmd.Flags().BoolVar(&fixOrgFlag, "fix-org", false, "Fix organization settings (requires organization admin)")
+	orgCmd.Flags().BoolVar(&summaryOnlyFlag, "summary-only", false, "Write one JSON line of issue counts per repository instead of the issues")
+	orgCmd.Flags().StringVar(&saveResultsFlag, "save-results", "", "Write the issues found in every repository to this JSON file, for the status command")
+	orgCmd.Flags().StringVar(&skipFlag, "skip", "", "Comma-separated list of checks to skip")
+	orgCmd.Flags().BoolVarP(&verboseFlag, "verbose", "v", false, "Enable verbose output")
+
+	return orgCmd
+}
+
+func runOrg(cmd *cobra.Command, args []string) error {
+	ctx := context.Background()
+	owner := args[0]
+
+	// Owner-scoped client for listing repositories and loading config
+	ownerClient, err := github.NewClient(owner, "", verboseFlag)
+	if err != nil {
+		return fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+
+	// Load configuration
+	var loadedConfig *config.LoadedConfig
+	if configFlag != "" {
+		loadedConfig, err = loadConfig(ownerClient)
+	} else {
+		loadedConfig, err = newLoader(ownerClient).LoadOwner()
+	}
+	if err != nil {
+		return fmt.Errorf("configuration error: %w", err)
+	}
+	if err := checkConfigWarnings(loadedConfig.Config); err != nil {
+		return err
+	}
+
+	repos, err := ownerClient.ListOwnerRepositories()
+	if err != nil {
+		return fmt.Errorf("failed to list repositories for %s: %w", owner, err)
+	}
+
+	repos, excluded, err := filterRepositories(repos, excludeRepoFlags, excludeForksFlag, excludeArchivedFlag)
+	if err != nil {
+		return err
+	}
+
+	if parallelReposFlag < 1 {
+		return fmt.Errorf("invalid --parallel-repos: %d (must be at least 1)", parallelReposFlag)
+	}
+
+	skip := parseSkip(skipFlag)
+	// Local checks are excluded per repository, but skipping them is not a mistake
+	known := append(checks.NewRunner(ownerClient, loadedConfig.Config, verboseFlag).GetCheckNames(), string(checks.CheckTypeOrganization))
+	if err := checks.ValidateCheckNames(skip, known); err != nil {
+		return fmt.Errorf("invalid --skip: %w", err)
+	}
+
+	// Organization settings are checked once, before the repositories
+	orgFailed := false
+	if loadedConfig.Config.Checks.Organization != nil && !slices.Contains(skip, string(checks.CheckTypeOrganization)) {
+		orgFailed, err = lintOrganization(ctx, ownerClient, loadedConfig.Config)
+		if err != nil {
+			return err
+		}
+	}
+
+	progress := newOrgProgress(os.Stderr, len(repos), term.IsTerminal(os.Stderr))
+	results := lintOrgRepositories(ctx, owner, repos, loadedConfig.Config, skip, parallelReposFlag, progress)
+	progress.clear()
+
+	if saveResultsFlag != "" {
+		saved := report.NewResults(version)
+		for _, result := range results {
+			saved.Add(owner+"/"+result.repo.Name, result.statuses, result.issues, result.err)
+		}
+		if err := report.SaveResults(saveResultsFlag, saved); err != nil {
+			return err
+		}
+	}
+
+	failedRepos := 0
+	for _, result := range results {
+		repo, issues := result.repo, result.issues
+		if summaryOnlyFlag {
+			if result.err != nil || checks.ErrorCount(issues) > 0 {
+				failedRepos++
+			}
+			if err := writeRepoSummary(owner, result); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if result.err != nil {
+			failedRepos++
+			fmt.Printf("%s/%s: error: %v\n", owner, repo.Name, result.err)
+			continue
+		}
+
+		if len(issues) == 0 {
+			if verboseFlag {
+				fmt.Printf("%s/%s: all checks passed\n", owner, repo.Name)
+			}
+			continue
+		}
+
+		if checks.ErrorCount(issues) > 0 {
+			failedRepos++
+		}
+		fmt.Printf("%s/%s: %d issue(s)\n", owner, repo.Name, len(issues))
+		for _, issue := range issues {
+			fmt.Printf("  [%s] %s\n", issue.Name, issue.Message)
+		}
+	}
+
+	// With --summary-only, stdout is kept to newline-delimited JSON
+	out := os.Stdout
+	if summaryOnlyFlag {
+		out = os.Stderr
+	} else {
+		fmt.Println()
+	}
+	_, _ = fmt.Fprintf(out, "Scanned %d repositories, %d failed\n", len(repos), failedRepos)
+	if summary := formatExclusions(excluded); summary != "" {
+		_, _ = fmt.Fprintf(out, "Excluded %s\n", summary)
+	}
+
+	if failedRepos > 0 {
+		return fmt.Errorf("%d repository(ies) failed validation", failedRepos)
+	}
+	if orgFailed {
+		return fmt.Errorf("organization %s failed validation", owner)
+	}
+
+	return nil
+}
+
+// writeRepoSummary writes one repository's result as a line of JSON
+func writeRepoSummary(owner string, result repoResult) error {
+	summary := report.NewSummary(owner+"/"+result.repo.Name, result.issues, result.duration)
+	if result.err != nil {
+		summary.Error = result.err.Error()
+	}
+	if err := report.WriteSummary(os.Stdout, report.FormatText, summary); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+	return nil
+}
+
+// lintOrganization checks the owner's organization settings, fixing them with --fix-org,
+// and reports whether any issue remains
+func lintOrganization(ctx context.Context, client *github.Client, cfg *config.Config) (bool, error) {
+	owner := client.Owner()
+	start := time.Now()
+	issues, err := checks.NewOrganizationCheck(client, cfg.Checks.Organization, verboseFlag).Run(ctx)
+	if err != nil {
+		return false, fmt.Errorf("organization check failed: %w", err)
+	}
+
+	if summaryOnlyFlag && !fixOrgFlag {
+		summary := report.NewSummary(owner, issues, time.Since(start))
+		if err := report.WriteSummary(os.Stdout, report.FormatText, summary); err != nil {
+			return false, fmt.Errorf("failed to write report: %w", err)
+		}
+		return checks.ErrorCount(issues) > 0, nil
+	}
+
+	if len(issues) == 0 {
+		if verboseFlag {
+			fmt.Printf("%s (organization): all checks passed\n", owner)
+		}
+		return false, nil
+	}
+
+	fmt.Printf("%s (organization): %d issue(s)\n", owner, len(issues))
+	if !fixOrgFlag {
+		for _, issue := range issues {
+			fmt.Printf("  [%s] %s\n", issue.Name, issue.Message)
+		}
+		return checks.ErrorCount(issues) > 0, nil
+	}
+
+	// Only fixable issues go to the orchestrator; the rest are reported, and fail the
+	// run only at error severity
+	var fixable []checks.Issue
+	failed := false
+	for _, issue := range issues {
+		switch {
+		case issue.Fixable:
+			fixable = append(fixable, issue)
+		case issue.Severity != checks.SeverityError:
+			fmt.Printf("  Not fixed (%s): [%s] %s\n", issue.Severity, issue.Name, issue.Message)
+		default:
+			failed = true
+			fmt.Printf("  Could not fix: [%s] %s (requires manual intervention)\n", issue.Name, issue.Message)
+		}
+	}
+
+	results, err := fix.NewOrchestrator(client, cfg, verboseFlag).Fix(ctx, fixable)
+	if err != nil {
+		return false, fmt.Errorf("fix failed: %w", err)
+	}
+
+	for _, result := range results {
+		if result.Fixed {
+			fmt.Printf("  Fixed: [%s] %s\n", result.Issue.Name, result.Issue.Message)
+			continue
+		}
+		failed = true
+		fmt.Printf("  Could not fix: [%s] %s (%s)\n", result.Issue.Name, result.Issue.Message, result.Error)
+	}
+	return failed, nil
+}
+
+// lintOrgRepositories lints repos with a pool of parallel workers and returns one
+// result per repository, in the same order as repos
+func lintOrgRepositories(ctx context.Context, owner string, repos []github.Repository, cfg *config.Config, skip []string, parallel int, progress *orgProgress) []repoResult {
+	results := make([]repoResult, len(repos))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for range min(parallel, len(repos)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				name := owner + "/" + repos[i].Name
+				progress.start(name)
+				start := time.Now()
+				results[i] = lintOrgRepositorySafely(ctx, owner, repos[i], cfg, skip, progress)
+				results[i].duration = time.Since(start)
+				progress.finish(name)
+			}
+		}()
+	}
+
+	for i := range repos {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// lintOrgRepositorySafely lints one repository, turning a panic into an error result
+// so that a single bad repository cannot abort the whole scan
+func lintOrgRepositorySafely(ctx context.Context, owner string, repo github.Repository, cfg *config.Config, skip []string, progress *orgProgress) (result repoResult) {
+	result.repo = repo
+	defer func() {
+		if r := recover(); r != nil {
+			result.statuses = nil
+			result.issues = nil
+			result.err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	result.statuses, result.issues, result.err = lintOrgRepository(ctx, owner, repo, cfg, skip, progress)
+	return result
+}
+
+// lintOrgRepository runs the repository-state checks against a single repository and
+// returns the status of each check along with the issues found
+func lintOrgRepository(ctx context.Context, owner string, repo github.Repository, cfg *config.Config, skip []string, progress *orgProgress) ([]checks.CheckStatus, []checks.Issue, error) {
+	client, err := github.NewClient(owner, repo.Name, verboseFlag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create GitHub client: %w", err)
+	}
+	client.SetRateLimitHandler(func(wait time.Duration) {
+		progress.rateLimited(owner+"/"+repo.Name, wait)
+	})
+	// Archived repositories that were not excluded get read-only checks
+	client.SetIncludeArchived(true)
+
+	runner := checks.NewRunner(client, cfg, verboseFlag)
+	runner.ExcludeTypes(checks.LocalCheckTypes...)
+
+	issues, err := runner.Run(ctx, skip)
+	if err != nil {
+		return nil, nil, fmt.Errorf("check failed: %w", err)
+	}
+	return runner.GetCheckStatuses(), issues, nil
+}
+
+// filterRepositories removes excluded repositories and returns the remaining ones
+// along with the number of repositories excluded for each reason. A repository
+// matching several exclusions is counted once, under the first matching reason.
+func filterRepositories(repos []github.Repository, patterns []string, excludeForks, excludeArchived bool) ([]github.Repository, map[string]int, error) {
+	globs := make([]glob.Glob, 0, len(patterns))
+	for _, pattern := range patterns {
+		g, err := glob.Compile(pattern)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid --exclude-repo pattern %q: %w", pattern, err)
+		}
+		globs = append(globs, g)
+	}
+
+	kept := make([]github.Repository, 0, len(repos))
+	excluded := make(map[string]int)
+
+	for _, repo := range repos {
+		switch {
+		case excludeArchived && repo.Archived:
+			excluded[excludedArchived]++
+		case excludeForks && repo.Fork:
+			excluded[excludedFork]++
+		case matchesAny(globs, repo.Name):
+			excluded[excludedByName]++
+		default:
+			kept = append(kept, repo)
+		}
+	}
+
+	return kept, excluded, nil
+}
+
+func matchesAny(globs []glob.Glob, name string) bool {
+	for _, g := range globs {
+		if g.Match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatExclusions summarizes exclusion counts, e.g. "3 repositories (2 archived, 1 fork)"
+func formatExclusions(excluded map[string]int) string {
+	total := 0
+	var parts []string
+	for _, reason := range []string{excludedArchived, excludedFork, excludedByName} {
+		if n := excluded[reason]; n > 0 {
+			total += n
+			parts = append(parts, fmt.Sprintf("%d %s", n, reason))
+		}
+	}
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d repositories (%s)", total, strings.Join(parts, ", "))
+}
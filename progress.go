@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// orgProgress reports org scan progress on a single, continually rewritten line.
+// Repositories are linted concurrently, so all methods are safe for concurrent use.
+// When disabled (e.g. stderr is not a terminal) only rate-limit waits are reported,
+// one per line, so that a long backoff is never silent.
+type orgProgress struct {
+	mu      sync.Mutex
+	w       io.Writer
+	total   int
+	done    int
+	enabled bool
+}
+
+func newOrgProgress(w io.Writer, total int, enabled bool) *orgProgress {
+	return &orgProgress{w: w, total: total, enabled: enabled}
+}
+
+// start notes that a repository has started linting
+func (p *orgProgress) start(repo string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.render(fmt.Sprintf("scanning %s", repo))
+}
+
+// finish notes that a repository has finished linting
+func (p *orgProgress) finish(repo string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done++
+	p.render(fmt.Sprintf("scanned %s", repo))
+}
+
+// rateLimited notes that a request for repo is waiting out a rate limit
+func (p *orgProgress) rateLimited(repo string, wait time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.enabled {
+		_, _ = fmt.Fprintf(p.w, "Rate limited on %s, waiting %v before retry...\n", repo, wait)
+		return
+	}
+	p.render(fmt.Sprintf("rate limited on %s, waiting %v", repo, wait))
+}
+
+// clear erases the progress line so that regular output starts on a clean line
+func (p *orgProgress) clear() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.enabled {
+		_, _ = fmt.Fprint(p.w, "\r\033[K")
+	}
+}
+
+func (p *orgProgress) render(status string) {
+	if !p.enabled {
+		return
+	}
+	_, _ = fmt.Fprintf(p.w, "\r\033[K%d/%d repos scanned, %s", p.done, p.total, status)
+}
@@ -0,0 +1,73 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+// GitHubFormatter writes GitHub Actions workflow commands so that issues appear as
+// annotations in the job log (and inline on the file, for file-scoped issues)
+//
+// See https://docs.github.com/actions/using-workflows/workflow-commands-for-github-actions
+type GitHubFormatter struct {
+	// MaxIssues limits the annotations written, when greater than 0
+	MaxIssues int
+}
+
+// Format writes one annotation per issue
+func (f *GitHubFormatter) Format(w io.Writer, issues []checks.Issue) error {
+	shown, hidden := truncateIssues(issues, f.MaxIssues)
+	for _, issue := range shown {
+		var props []string
+		if issue.File != "" {
+			props = append(props, "file="+escapeProperty(issue.File))
+			if issue.Line > 0 {
+				props = append(props, fmt.Sprintf("line=%d", issue.Line))
+			}
+		}
+		props = append(props, "title="+escapeProperty(issue.Name))
+
+		message := issue.Message
+		if issue.HelpURL != "" {
+			message += "\nSee " + issue.HelpURL
+		}
+
+		_, _ = fmt.Fprintf(w, "::%s %s::%s\n", annotationLevel(issue), strings.Join(props, ","), escapeData(message))
+	}
+	if hidden > 0 {
+		_, _ = fmt.Fprintf(w, "::notice title=repolint::... and %d more\n", hidden)
+	}
+	return nil
+}
+
+// annotationLevel maps an issue to an annotation command. File-scoped issues are
+// errors and repository-level issues are warnings, downgraded further by severity.
+func annotationLevel(issue checks.Issue) string {
+	switch {
+	case issue.Severity == checks.SeverityInfo:
+		return "notice"
+	case issue.Severity == checks.SeverityWarning, issue.File == "":
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// escapeData escapes a workflow command message
+func escapeData(s string) string {
+	s = strings.ReplaceAll(s, "%", "%25")
+	s = strings.ReplaceAll(s, "\r", "%0D")
+	s = strings.ReplaceAll(s, "\n", "%0A")
+	return s
+}
+
+// escapeProperty escapes a workflow command property value
+func escapeProperty(s string) string {
+	s = escapeData(s)
+	s = strings.ReplaceAll(s, ":", "%3A")
+	s = strings.ReplaceAll(s, ",", "%2C")
+	return s
+}
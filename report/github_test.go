@@ -0,0 +1,32 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+func TestGitHubFormatter_Format(t *testing.T) {
+	issues := []checks.Issue{
+		{Name: "actions", Message: "Action 'foo/bar@v1' is not pinned", File: ".github/workflows/ci.yml", Line: 12},
+		{Name: "settings", Message: "Wiki is enabled but should be disabled", HelpURL: "https://example.com/settings"},
+		{Name: "files(a,b)", Message: "100% wrong\nsecond line", File: "a", Severity: checks.SeverityInfo},
+	}
+
+	var sb strings.Builder
+	if err := (&GitHubFormatter{}).Format(&sb, issues); err != nil {
+		t.Fatalf("Format() returned unexpected error: %v", err)
+	}
+
+	want := strings.Join([]string{
+		"::error file=.github/workflows/ci.yml,line=12,title=actions::Action 'foo/bar@v1' is not pinned",
+		"::warning title=settings::Wiki is enabled but should be disabled%0ASee https://example.com/settings",
+		"::notice file=a,title=files(a%2Cb)::100%25 wrong%0Asecond line",
+		"",
+	}, "\n")
+
+	if got := sb.String(); got != want {
+		t.Errorf("Format() output mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,52 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/fix"
+)
+
+// JSONFormatter writes issues, and fix results, as an indented JSON array
+type JSONFormatter struct{}
+
+// FixResult is a fix.Result as written by --fix --format json
+type FixResult struct {
+	Issue ResultIssue `json:"issue"`
+	Fixed bool        `json:"fixed"`
+	// Error is the reason the issue was not fixed, if any
+	Error string `json:"error,omitempty"`
+}
+
+// Format writes the issues as [{fingerprint, type, name, severity, message, fixable, ...}],
+// in the same form as saved results
+func (f *JSONFormatter) Format(w io.Writer, issues []checks.Issue) error {
+	out := make([]ResultIssue, 0, len(issues))
+	for _, issue := range issues {
+		out = append(out, newResultIssue(issue))
+	}
+	return writeJSON(w, out)
+}
+
+// FormatFixes writes the fix results as [{issue, fixed, error}]
+func (f *JSONFormatter) FormatFixes(w io.Writer, results []fix.Result) error {
+	out := make([]FixResult, 0, len(results))
+	for _, result := range results {
+		fixResult := FixResult{Issue: newResultIssue(result.Issue), Fixed: result.Fixed}
+		if result.Error != nil {
+			fixResult.Error = result.Error.Error()
+		}
+		out = append(out, fixResult)
+	}
+	return writeJSON(w, out)
+}
+
+func writeJSON(w io.Writer, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
@@ -0,0 +1,66 @@
+package report
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/fix"
+)
+
+func TestJSONFormatter_FormatFixes(t *testing.T) {
+	results := []fix.Result{
+		{Issue: checks.Issue{Type: checks.CheckTypeSettings, Name: "settings", Message: "Wiki is enabled", Fixable: true, HelpURL: "https://example.com/settings"}, Fixed: true},
+		{Issue: checks.Issue{Type: checks.CheckTypeLabels, Name: "labels", Message: "Label 'bug' is missing", Fixable: true, Severity: checks.SeverityWarning}, Error: errors.New("403 Forbidden")},
+	}
+
+	var sb strings.Builder
+	if err := (&JSONFormatter{}).FormatFixes(&sb, results); err != nil {
+		t.Fatalf("FormatFixes() returned unexpected error: %v", err)
+	}
+
+	want := `[
+  {
+    "issue": {
+      "fingerprint": "76a20ebeb86c7e86",
+      "type": "settings",
+      "name": "settings",
+      "severity": "error",
+      "message": "Wiki is enabled",
+      "fixable": true,
+      "help_url": "https://example.com/settings"
+    },
+    "fixed": true
+  },
+  {
+    "issue": {
+      "fingerprint": "a511e74f86abe94f",
+      "type": "labels",
+      "name": "labels",
+      "severity": "warning",
+      "message": "Label 'bug' is missing",
+      "fixable": true
+    },
+    "fixed": false,
+    "error": "403 Forbidden"
+  }
+]
+`
+	if got := sb.String(); got != want {
+		t.Errorf("FormatFixes() output mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestJSONFormatter_NoIssues(t *testing.T) {
+	var sb strings.Builder
+	if err := (&JSONFormatter{}).Format(&sb, nil); err != nil {
+		t.Fatalf("Format() returned unexpected error: %v", err)
+	}
+	if err := (&JSONFormatter{}).FormatFixes(&sb, nil); err != nil {
+		t.Fatalf("FormatFixes() returned unexpected error: %v", err)
+	}
+	if got, want := sb.String(), "[]\n[]\n"; got != want {
+		t.Errorf("Format() and FormatFixes() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,61 @@
+// Package report formats lint results for output.
+package report
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sethrylan/gh-repolint/checks"
+	"github.com/sethrylan/gh-repolint/fix"
+)
+
+// Output formats accepted by --format
+const (
+	FormatText     = "text"
+	FormatGitHub   = "github"
+	FormatTemplate = "template"
+	FormatJSON     = "json"
+)
+
+// Formats lists the supported output formats
+var Formats = []string{FormatText, FormatGitHub, FormatTemplate, FormatJSON}
+
+// Formatter writes the issues found by a lint run
+type Formatter interface {
+	Format(w io.Writer, issues []checks.Issue) error
+}
+
+// FixFormatter is a Formatter that also writes the results of a --fix run; with other
+// formatters, fix results are written as text
+type FixFormatter interface {
+	Formatter
+	FormatFixes(w io.Writer, results []fix.Result) error
+}
+
+// truncateIssues returns the first limit issues (all of them when limit is 0) and the
+// number of issues left out
+func truncateIssues(issues []checks.Issue, limit int) ([]checks.Issue, int) {
+	if limit <= 0 || len(issues) <= limit {
+		return issues, 0
+	}
+	return issues[:limit], len(issues) - limit
+}
+
+// NewFormatter returns the formatter for the named output format
+// useColor only affects human-readable formats
+func NewFormatter(format string, useColor bool) (Formatter, error) {
+	switch format {
+	case "", FormatText:
+		return &TextFormatter{Color: useColor}, nil
+	case FormatGitHub:
+		return &GitHubFormatter{}, nil
+	case FormatJSON:
+		return &JSONFormatter{}, nil
+	case FormatTemplate:
+		// Templates need their text; see NewTemplateFormatter
+		return nil, fmt.Errorf("the %s format requires --template or --template-file", FormatTemplate)
+	default:
+		return nil, fmt.Errorf("unknown format %q (must be one of: %s)", format, strings.Join(Formats, ", "))
+	}
+}
@@ -0,0 +1,224 @@
+package report
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+// ResultsVersion is the schema version of files written by --save-results
+const ResultsVersion = 1
+
+// Results is a saved lint run, written by --save-results and read by the status command
+type Results struct {
+	Version int `json:"version"`
+	// ToolVersion is the gh-repolint version that produced the results
+	ToolVersion string        `json:"tool_version"`
+	CreatedAt   time.Time     `json:"created_at"`
+	Repos       []RepoResults `json:"repos"`
+}
+
+// RepoResults is the outcome of linting one repository
+type RepoResults struct {
+	Repo   string        `json:"repo"`
+	Checks []ResultCheck `json:"checks,omitempty"`
+	Issues []ResultIssue `json:"issues"`
+	// Error is set when the repository could not be linted
+	Error string `json:"error,omitempty"`
+}
+
+// Check statuses recorded in a results file
+const (
+	CheckPassed  = "passed"
+	CheckFailed  = "failed"
+	CheckSkipped = "skipped"
+	// CheckErrored is a check that failed to run, with --continue-on-error
+	CheckErrored = "errored"
+)
+
+// ResultCheck is the status of one check in a repository
+type ResultCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// ResultIssue is an issue in a saved results file
+type ResultIssue struct {
+	// Fingerprint identifies the issue across runs; see checks.Issue.Fingerprint
+	Fingerprint string `json:"fingerprint"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Severity    string `json:"severity"`
+	Message     string `json:"message"`
+	Fixable     bool   `json:"fixable"`
+	File        string `json:"file,omitempty"`
+	Line        int    `json:"line,omitempty"`
+	HelpURL     string `json:"help_url,omitempty"`
+}
+
+// NewResults returns an empty results file created now by toolVersion
+func NewResults(toolVersion string) *Results {
+	return &Results{
+		Version:     ResultsVersion,
+		ToolVersion: toolVersion,
+		CreatedAt:   time.Now().UTC(),
+		Repos:       []RepoResults{},
+	}
+}
+
+// Add records the checks run against a repository ("owner/name") and the issues found,
+// or the error that prevented linting it. A check fails when it reports an error-severity issue.
+func (r *Results) Add(repo string, statuses []checks.CheckStatus, issues []checks.Issue, err error) {
+	result := RepoResults{Repo: repo, Issues: make([]ResultIssue, 0, len(issues))}
+	if err != nil {
+		result.Error = err.Error()
+	}
+
+	failed := make(map[string]bool)
+	for _, issue := range issues {
+		if issue.Severity == checks.SeverityError {
+			failed[issue.Name] = true
+		}
+	}
+	for _, status := range statuses {
+		check := ResultCheck{Name: status.Name, Status: CheckPassed}
+		switch {
+		case status.Skipped:
+			check.Status = CheckSkipped
+		case status.Error != "":
+			check.Status = CheckErrored
+		case failed[status.Name]:
+			check.Status = CheckFailed
+		}
+		result.Checks = append(result.Checks, check)
+	}
+
+	for _, issue := range issues {
+		result.Issues = append(result.Issues, newResultIssue(issue))
+	}
+	r.Repos = append(r.Repos, result)
+}
+
+// newResultIssue returns the saved form of an issue
+func newResultIssue(issue checks.Issue) ResultIssue {
+	return ResultIssue{
+		Fingerprint: issue.Fingerprint(),
+		Type:        string(issue.Type),
+		Name:        issue.Name,
+		Severity:    issue.Severity.String(),
+		Message:     issue.Message,
+		Fixable:     issue.Fixable,
+		File:        issue.File,
+		Line:        issue.Line,
+		HelpURL:     issue.HelpURL,
+	}
+}
+
+// Failed reports whether the repository had an error, a check that errored or an
+// error-severity issue
+func (r RepoResults) Failed() bool {
+	if r.Error != "" {
+		return true
+	}
+	if slices.ContainsFunc(r.Checks, func(check ResultCheck) bool { return check.Status == CheckErrored }) {
+		return true
+	}
+	return slices.ContainsFunc(r.Issues, func(issue ResultIssue) bool {
+		return issue.Severity == checks.SeverityError.String()
+	})
+}
+
+// SaveResults writes results as indented JSON to path
+func SaveResults(path string, results *Results) error {
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to save results: %w", err)
+	}
+	return nil
+}
+
+// LoadResults reads a results file written by SaveResults
+func LoadResults(path string) (*Results, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results: %w", err)
+	}
+	var results Results
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("failed to parse results %s: %w", path, err)
+	}
+	if results.Version != ResultsVersion {
+		return nil, fmt.Errorf("unsupported results version %d in %s (expected %d)", results.Version, path, ResultsVersion)
+	}
+	return &results, nil
+}
+
+// Status summarizes compliance across the repositories of a results file
+type Status struct {
+	Repos   int
+	Passing int
+	Failing int
+	// Errored counts failing repositories that could not be linted
+	Errored int
+	// FailingChecks lists checks by the number of repositories failing them, most first
+	FailingChecks []CheckFailures
+}
+
+// CheckFailures is the number of repositories with an error-severity issue from a check
+type CheckFailures struct {
+	Name  string
+	Repos int
+}
+
+// NewStatus aggregates saved results into a compliance status
+func NewStatus(results *Results) Status {
+	status := Status{Repos: len(results.Repos)}
+	failures := make(map[string]int)
+
+	for _, repo := range results.Repos {
+		if !repo.Failed() {
+			status.Passing++
+			continue
+		}
+		status.Failing++
+		if repo.Error != "" {
+			status.Errored++
+		}
+
+		failed := make(map[string]bool)
+		for _, issue := range repo.Issues {
+			if issue.Severity == checks.SeverityError.String() && !failed[issue.Name] {
+				failed[issue.Name] = true
+				failures[issue.Name]++
+			}
+		}
+	}
+
+	for name, repos := range failures {
+		status.FailingChecks = append(status.FailingChecks, CheckFailures{Name: name, Repos: repos})
+	}
+	slices.SortFunc(status.FailingChecks, func(a, b CheckFailures) int {
+		if c := cmp.Compare(b.Repos, a.Repos); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return status
+}
+
+// PassingPercent returns the percentage of repositories passing, or 0 with no repositories
+func (s Status) PassingPercent() float64 {
+	if s.Repos == 0 {
+		return 0
+	}
+	return float64(s.Passing) * 100 / float64(s.Repos)
+}
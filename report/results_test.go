@@ -0,0 +1,70 @@
+package report
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+func TestResultsRoundTripAndStatus(t *testing.T) {
+	results := NewResults("v1.2.3")
+	results.Add("me/passing", nil, nil, nil)
+	results.Add("me/warned", nil, []checks.Issue{{Type: checks.CheckTypeSettings, Name: "settings", Severity: checks.SeverityWarning}}, nil)
+	results.Add("me/failing", []checks.CheckStatus{{Name: "settings"}, {Name: "rulesets(main)"}, {Name: "actions", Skipped: true}, {Name: "labels"}, {Name: "topics", Error: "HTTP 502"}}, []checks.Issue{
+		{Type: checks.CheckTypeSettings, Name: "settings", Message: "Wiki is enabled but should be disabled"},
+		{Type: checks.CheckTypeSettings, Name: "settings", Message: "Issues is enabled but should be disabled"},
+		{Type: checks.CheckTypeRulesets, Name: "rulesets(main)", Message: "Ruleset 'main' is missing"},
+	}, nil)
+	results.Add("me/other", nil, []checks.Issue{{Type: checks.CheckTypeSettings, Name: "settings"}}, nil)
+	results.Add("me/broken", nil, nil, errors.New("check failed"))
+
+	path := filepath.Join(t.TempDir(), "results.json")
+	if err := SaveResults(path, results); err != nil {
+		t.Fatalf("SaveResults() error = %v", err)
+	}
+	loaded, err := LoadResults(path)
+	if err != nil {
+		t.Fatalf("LoadResults() error = %v", err)
+	}
+
+	if loaded.ToolVersion != "v1.2.3" {
+		t.Errorf("ToolVersion = %q, want v1.2.3", loaded.ToolVersion)
+	}
+	failing := loaded.Repos[2]
+	wantChecks := []ResultCheck{{"settings", CheckFailed}, {"rulesets(main)", CheckFailed}, {"actions", CheckSkipped}, {"labels", CheckPassed}, {"topics", CheckErrored}}
+	if len(failing.Checks) != len(wantChecks) {
+		t.Fatalf("Checks = %+v, want %+v", failing.Checks, wantChecks)
+	}
+	for i := range wantChecks {
+		if failing.Checks[i] != wantChecks[i] {
+			t.Errorf("Checks[%d] = %+v, want %+v", i, failing.Checks[i], wantChecks[i])
+		}
+	}
+	if failing.Issues[0].Fingerprint == "" || failing.Issues[0].Fingerprint == failing.Issues[1].Fingerprint {
+		t.Errorf("issues should have distinct fingerprints, got %q and %q", failing.Issues[0].Fingerprint, failing.Issues[1].Fingerprint)
+	}
+
+	if errored := (RepoResults{Checks: []ResultCheck{{"topics", CheckErrored}}}); !errored.Failed() {
+		t.Error("a repository with an errored check should fail")
+	}
+
+	status := NewStatus(loaded)
+	if status.Repos != 5 || status.Passing != 2 || status.Failing != 3 || status.Errored != 1 {
+		t.Errorf("NewStatus() = %+v, want 5 repos, 2 passing, 3 failing, 1 errored", status)
+	}
+	if got := status.PassingPercent(); got != 40 {
+		t.Errorf("PassingPercent() = %v, want 40", got)
+	}
+
+	want := []CheckFailures{{Name: "settings", Repos: 2}, {Name: "rulesets(main)", Repos: 1}}
+	if len(status.FailingChecks) != len(want) {
+		t.Fatalf("FailingChecks = %+v, want %+v", status.FailingChecks, want)
+	}
+	for i := range want {
+		if status.FailingChecks[i] != want[i] {
+			t.Errorf("FailingChecks[%d] = %+v, want %+v", i, status.FailingChecks[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+// Summary is the issue counts of a lint run, written instead of the issues by --summary-only
+type Summary struct {
+	Repo       string         `json:"repo"`
+	Total      int            `json:"total"`
+	Fixable    int            `json:"fixable"`
+	ByType     map[string]int `json:"by_type"`
+	DurationMS int64          `json:"duration_ms"`
+	// Error is set when the repository could not be linted
+	Error string `json:"error,omitempty"`
+}
+
+// NewSummary counts issues for a repository ("owner/name")
+func NewSummary(repo string, issues []checks.Issue, duration time.Duration) Summary {
+	summary := Summary{
+		Repo:       repo,
+		Total:      len(issues),
+		ByType:     make(map[string]int),
+		DurationMS: duration.Milliseconds(),
+	}
+	for _, issue := range issues {
+		if issue.Fixable {
+			summary.Fixable++
+		}
+		summary.ByType[string(issue.Type)]++
+	}
+	return summary
+}
+
+// WriteSummary writes the summary as a single line: a JSON object for the text format,
+// or a notice annotation for the github format
+func WriteSummary(w io.Writer, format string, summary Summary) error {
+	switch format {
+	case "", FormatText, FormatJSON:
+		data, err := json.Marshal(summary)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", data)
+		return err
+	case FormatGitHub:
+		counts := make([]string, 0, len(summary.ByType))
+		for _, checkType := range slices.Sorted(maps.Keys(summary.ByType)) {
+			counts = append(counts, fmt.Sprintf("%s=%d", checkType, summary.ByType[checkType]))
+		}
+		message := fmt.Sprintf("%s: %d issue(s), %d fixable", summary.Repo, summary.Total, summary.Fixable)
+		if len(counts) > 0 {
+			message += " (" + strings.Join(counts, ", ") + ")"
+		}
+		message += fmt.Sprintf(" in %dms", summary.DurationMS)
+		_, err := fmt.Fprintf(w, "::notice title=repolint summary::%s\n", escapeData(message))
+		return err
+	default:
+		return fmt.Errorf("unknown format %q (must be one of: %s)", format, strings.Join(Formats, ", "))
+	}
+}
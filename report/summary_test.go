@@ -0,0 +1,38 @@
+package report
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+func TestWriteSummary(t *testing.T) {
+	issues := []checks.Issue{
+		{Type: checks.CheckTypeSettings, Message: "Wiki is enabled but should be disabled", Fixable: true},
+		{Type: checks.CheckTypeSettings, Message: "Homepage is not set"},
+		{Type: checks.CheckTypeActions, Message: "Action 'foo/bar@v1' is not pinned"},
+	}
+	summary := NewSummary("me/repo", issues, 1500*time.Millisecond)
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{FormatText, `{"repo":"me/repo","total":3,"fixable":1,"by_type":{"actions":1,"settings":2},"duration_ms":1500}` + "\n"},
+		{FormatGitHub, "::notice title=repolint summary::me/repo: 3 issue(s), 1 fixable (actions=1, settings=2) in 1500ms\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var sb strings.Builder
+			if err := WriteSummary(&sb, tt.format, summary); err != nil {
+				t.Fatalf("WriteSummary() returned unexpected error: %v", err)
+			}
+			if got := sb.String(); got != tt.want {
+				t.Errorf("WriteSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+// TemplateData is the context a user-supplied template is executed against
+type TemplateData struct {
+	// Repo is the repository being linted ("owner/name")
+	Repo string
+	// Issues are the issues found, in check order
+	Issues []checks.Issue
+	// Checks are the checks that ran
+	Checks []checks.CheckStatus
+	// Summary holds the issue counts, as written by --summary-only
+	Summary Summary
+}
+
+// TemplateFormatter executes a user-supplied text/template, as an escape hatch for
+// output formats that are not built in. Repo, Checks and Duration are set by the
+// caller before Format is called.
+type TemplateFormatter struct {
+	Template *template.Template
+	Repo     string
+	Checks   []checks.CheckStatus
+	Duration time.Duration
+}
+
+// templateFuncs are the helper functions available to templates
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"fixable": func(issues []checks.Issue) []checks.Issue {
+		var fixable []checks.Issue
+		for _, issue := range issues {
+			if issue.Fixable {
+				fixable = append(fixable, issue)
+			}
+		}
+		return fixable
+	},
+	"ofType": func(checkType string, issues []checks.Issue) []checks.Issue {
+		var matching []checks.Issue
+		for _, issue := range issues {
+			if string(issue.Type) == checkType {
+				matching = append(matching, issue)
+			}
+		}
+		return matching
+	},
+}
+
+// NewTemplateFormatter parses a template given inline or, when text is empty, read from file
+func NewTemplateFormatter(text, file string) (*TemplateFormatter, error) {
+	name := "template"
+	switch {
+	case text != "" && file != "":
+		return nil, fmt.Errorf("--template and --template-file cannot be combined")
+	case file != "":
+		content, err := os.ReadFile(file) //nolint:gosec // Reading a user-specified template is intentional
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template file: %w", err)
+		}
+		name, text = file, string(content)
+	case text == "":
+		return nil, fmt.Errorf("the %s format requires --template or --template-file", FormatTemplate)
+	}
+
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return &TemplateFormatter{Template: tmpl}, nil
+}
+
+// Format executes the template against the issues
+func (f *TemplateFormatter) Format(w io.Writer, issues []checks.Issue) error {
+	data := TemplateData{
+		Repo:    f.Repo,
+		Issues:  issues,
+		Checks:  f.Checks,
+		Summary: NewSummary(f.Repo, issues, f.Duration),
+	}
+	if err := f.Template.Execute(w, data); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return nil
+}
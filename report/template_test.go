@@ -0,0 +1,41 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+func TestTemplateFormatter_Format(t *testing.T) {
+	issues := []checks.Issue{
+		{Type: checks.CheckTypeSettings, Name: "settings", Message: "Wiki is enabled but should be disabled", Fixable: true},
+		{Type: checks.CheckTypeActions, Name: "actions", Message: "Action 'foo/bar@v1' is not pinned", Severity: checks.SeverityWarning},
+	}
+
+	const text = `{{.Repo}}: {{.Summary.Total}} issue(s)
+{{range .Issues}}- {{upper .Name}} [{{.Severity}}] {{.Message}}
+{{end}}fixable: {{len (fixable .Issues)}}, actions: {{len (ofType "actions" .Issues)}}
+`
+	f, err := NewTemplateFormatter(text, "")
+	if err != nil {
+		t.Fatalf("NewTemplateFormatter() returned unexpected error: %v", err)
+	}
+	f.Repo = "me/repo"
+
+	var sb strings.Builder
+	if err := f.Format(&sb, issues); err != nil {
+		t.Fatalf("Format() returned unexpected error: %v", err)
+	}
+
+	want := strings.Join([]string{
+		"me/repo: 2 issue(s)",
+		"- SETTINGS [error] Wiki is enabled but should be disabled",
+		"- ACTIONS [warning] Action 'foo/bar@v1' is not pinned",
+		"fixable: 1, actions: 1",
+		"",
+	}, "\n")
+	if got := sb.String(); got != want {
+		t.Errorf("Format() output mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
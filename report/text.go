@@ -0,0 +1,66 @@
+package report
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// TextFormatter writes human-readable output
+type TextFormatter struct {
+	Color bool
+	// MaxIssues limits the issues written, when greater than 0; the fixable count still
+	// covers every issue
+	MaxIssues int
+}
+
+// Format writes each issue on its own line followed by a fixable summary
+func (f *TextFormatter) Format(w io.Writer, issues []checks.Issue) error {
+	if checks.ErrorCount(issues) > 0 {
+		_, _ = fmt.Fprintln(w, f.colorize("Repository validation failed:", colorRed))
+	} else {
+		_, _ = fmt.Fprintln(w, f.colorize("Repository validation passed with warnings:", colorYellow))
+	}
+	fixableCount := 0
+	for _, issue := range issues {
+		if issue.Fixable {
+			fixableCount++
+		}
+	}
+
+	shown, hidden := truncateIssues(issues, f.MaxIssues)
+	for _, issue := range shown {
+		fixable := ""
+		if issue.Fixable {
+			fixable = " " + f.colorize("(fixable)", colorGreen)
+		}
+		severity := ""
+		if issue.Severity != checks.SeverityError {
+			severity = " (" + issue.Severity.String() + ")"
+		}
+		_, _ = fmt.Fprintf(w, "  %s%s %s%s\n", f.colorize("["+issue.Name+"]", colorYellow), severity, issue.Message, fixable)
+	}
+	if hidden > 0 {
+		_, _ = fmt.Fprintf(w, "  ... and %d more\n", hidden)
+	}
+	_, _ = fmt.Fprintln(w)
+	if fixableCount > 0 {
+		_, _ = fmt.Fprintf(w, "Run with --fix to automatically fix %d issue(s)\n", fixableCount)
+	}
+	return nil
+}
+
+func (f *TextFormatter) colorize(s, color string) string {
+	if !f.Color {
+		return s
+	}
+	return color + s + colorReset
+}
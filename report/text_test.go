@@ -0,0 +1,31 @@
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/sethrylan/gh-repolint/checks"
+)
+
+func TestTextFormatterMaxIssues(t *testing.T) {
+	issues := []checks.Issue{
+		{Name: "settings", Message: "Wiki is enabled but should be disabled", Fixable: true},
+		{Name: "settings", Message: "Issues is enabled but should be disabled", Fixable: true},
+		{Name: "actions", Message: "Action 'foo/bar@v1' is not pinned"},
+	}
+
+	var sb strings.Builder
+	if err := (&TextFormatter{MaxIssues: 1}).Format(&sb, issues); err != nil {
+		t.Fatalf("Format() error = %v", err)
+	}
+
+	want := `Repository validation failed:
+  [settings] Wiki is enabled but should be disabled (fixable)
+  ... and 2 more
+
+Run with --fix to automatically fix 2 issue(s)
+`
+	if got := sb.String(); got != want {
+		t.Errorf("Format() =\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sethrylan/gh-repolint/report"
+)
+
+var statusTopFlag int
+
+// defaultStatusTop is the number of failing checks listed by the status command
+const defaultStatusTop = 5
+
+func newStatusCmd() *cobra.Command {
+	statusCmd := &cobra.Command{
+		Use:   "status <results.json>",
+		Short: "Summarize compliance from results saved with --save-results",
+		Long: `Summarize compliance from a results file written by --save-results, without
+re-scanning: the percentage of repositories passing and the checks failing in the
+most repositories.`,
+		Args: cobra.ExactArgs(1),
+		RunE: runStatus,
+	}
+
+	statusCmd.Flags().IntVar(&statusTopFlag, "top", defaultStatusTop, "Number of failing checks to list")
+
+	return statusCmd
+}
+
+func runStatus(cmd *cobra.Command, args []string) error {
+	results, err := report.LoadResults(args[0])
+	if err != nil {
+		return err
+	}
+
+	status := report.NewStatus(results)
+	fmt.Printf("Results from %s\n", results.CreatedAt.Local().Format("2006-01-02 15:04"))
+	fmt.Printf("%d of %d repositories passing (%.1f%%)\n", status.Passing, status.Repos, status.PassingPercent())
+	if status.Errored > 0 {
+		fmt.Printf("%d repositories could not be linted\n", status.Errored)
+	}
+
+	if len(status.FailingChecks) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+	fmt.Println("Top failing checks:")
+	for i, check := range status.FailingChecks {
+		if i == statusTopFlag {
+			break
+		}
+		fmt.Printf("  %-30s %d repositories\n", check.Name, check.Repos)
+	}
+	return nil
+}